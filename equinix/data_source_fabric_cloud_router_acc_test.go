@@ -101,3 +101,38 @@ func ConfigCreateCloudRouterResource_PFCR() string {
 	}
 `
 }
+
+func TestAccDataSourceFabricCloudRouterPackages_PFCR(t *testing.T) {
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: ConfigCloudRouterPackages_PFCR(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.equinix_fabric_cloud_router_package.standard", "code", "STANDARD"),
+					resource.TestCheckResourceAttr("data.equinix_fabric_cloud_router_package.standard", "type", "ROUTER_PACKAGE"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_cloud_router_package.standard", "href"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_cloud_router_package.standard", "total_ipv4_routes_max"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_cloud_router_package.standard", "vc_count_max"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_cloud_router_package.standard", "vc_bandwidth_max"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_cloud_router_package.standard", "route_filter_supported"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_cloud_router_packages.all", "data.0.code"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_cloud_router_packages.all", "data.0.total_ipv4_routes_max"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_cloud_router_packages.all", "data.0.vc_count_max"),
+				),
+			},
+		},
+	})
+}
+
+func ConfigCloudRouterPackages_PFCR() string {
+	return `
+		data "equinix_fabric_cloud_router_package" "standard" {
+			code = "STANDARD"
+		}
+
+		data "equinix_fabric_cloud_router_packages" "all" {}
+	`
+}
@@ -0,0 +1,158 @@
+package equinix
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/equinix/terraform-provider-equinix/internal/config"
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	equinix_schema "github.com/equinix/terraform-provider-equinix/internal/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func fabricCloudRouterPackageCodes() []string {
+	codes := make([]string, len(fabricv4.AllowedCodeEnumValues))
+	for i, code := range fabricv4.AllowedCodeEnumValues {
+		codes[i] = string(code)
+	}
+	return codes
+}
+
+func readFabricCloudRouterPackageSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"code": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  fmt.Sprintf("Cloud Router package code. One of %v", fabricCloudRouterPackageCodes()),
+			ValidateFunc: validation.StringInSlice(fabricCloudRouterPackageCodes(), false),
+		},
+		"href": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Cloud Router package URI",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Type of the Cloud Router package",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Cloud Router package description",
+		},
+		"total_ipv4_routes_max": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Maximum number of BGP IPv4 routes of the Cloud Router package",
+		},
+		"total_ipv6_routes_max": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Maximum number of BGP IPv6 routes of the Cloud Router package",
+		},
+		"route_filter_supported": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether route filters are supported by the Cloud Router package",
+		},
+		"vc_count_max": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Maximum number of connections of a Cloud Router of the package",
+		},
+		"cr_count_max": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Maximum number of Cloud Routers of the package",
+		},
+		"vc_bandwidth_max": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Maximum bandwidth of the connections of a Cloud Router of the package, in Mbps",
+		},
+		"change_log": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Description: "Cloud Router package lifecycle change information",
+			Elem: &schema.Resource{
+				Schema: fabricCloudRouterPackageChangeLogSch(),
+			},
+		},
+	}
+}
+
+func fabricCloudRouterPackageChangeLogSch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"created_date_time": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Creation time of the Cloud Router package",
+		},
+		"updated_date_time": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Last update time of the Cloud Router package",
+		},
+	}
+}
+
+func dataSourceFabricCloudRouterPackage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFabricCloudRouterPackageRead,
+		Schema:      readFabricCloudRouterPackageSchema(),
+		Description: `Fabric V4 API compatible data resource that allow user to fetch Fabric Cloud Router Package for a given package code
+
+Additional documentation:
+* Getting Started: https://docs.equinix.com/fabric-cloud-router/
+* API: https://docs.equinix.com/api-catalog/fabricv4/#tag/Cloud-Routers`,
+	}
+}
+
+func dataSourceFabricCloudRouterPackageRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
+	code := d.Get("code").(string)
+	cloudRouterPackage, _, err := client.CloudRoutersApi.GetCloudRouterPackageByCode(ctx, fabricv4.RouterPackageCode(code)).Execute()
+	if err != nil {
+		return diag.FromErr(equinix_errors.FormatFabricError(err))
+	}
+	d.SetId(code)
+	if err := equinix_schema.SetMap(d, fabricCloudRouterPackageMap(cloudRouterPackage)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func fabricCloudRouterPackageMap(cloudRouterPackage *fabricv4.CloudRouterPackage) map[string]any {
+	return map[string]any{
+		"code":                   string(cloudRouterPackage.GetCode()),
+		"href":                   cloudRouterPackage.GetHref(),
+		"type":                   string(cloudRouterPackage.GetType()),
+		"description":            cloudRouterPackage.GetDescription(),
+		"total_ipv4_routes_max":  int(cloudRouterPackage.GetTotalIPv4RoutesMax()),
+		"total_ipv6_routes_max":  int(cloudRouterPackage.GetTotalIPv6RoutesMax()),
+		"route_filter_supported": cloudRouterPackage.GetRouteFilterSupported(),
+		"vc_count_max":           int(cloudRouterPackage.GetVcCountMax()),
+		"cr_count_max":           int(cloudRouterPackage.GetCrCountMax()),
+		"vc_bandwidth_max":       int(cloudRouterPackage.GetVcBandwidthMax()),
+		"change_log":             fabricCloudRouterPackageChangeLogGoToTerraform(cloudRouterPackage.ChangeLog),
+	}
+}
+
+func fabricCloudRouterPackageChangeLogGoToTerraform(changeLog *fabricv4.PackageChangeLog) *schema.Set {
+	if changeLog == nil {
+		return nil
+	}
+	mappedChangeLog := map[string]any{
+		"created_date_time": changeLog.GetCreatedDateTime().Format(time.RFC3339),
+		"updated_date_time": changeLog.GetUpdatedDateTime().Format(time.RFC3339),
+	}
+	return schema.NewSet(
+		schema.HashResource(&schema.Resource{Schema: fabricCloudRouterPackageChangeLogSch()}),
+		[]any{mappedChangeLog},
+	)
+}
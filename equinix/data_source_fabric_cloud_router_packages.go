@@ -0,0 +1,82 @@
+package equinix
+
+import (
+	"context"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/equinix/terraform-provider-equinix/internal/config"
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	equinix_schema "github.com/equinix/terraform-provider-equinix/internal/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// fabricCloudRouterPackagesPageSize is the number of packages requested per
+// page when retrieving the Cloud Router package catalog
+const fabricCloudRouterPackagesPageSize = 20
+
+func readFabricCloudRouterPackagesSchema() map[string]*schema.Schema {
+	packageSchema := readFabricCloudRouterPackageSchema()
+	packageSchema["code"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Cloud Router package code",
+	}
+	return map[string]*schema.Schema{
+		"data": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "List of Cloud Router packages",
+			Elem: &schema.Resource{
+				Schema: packageSchema,
+			},
+		},
+	}
+}
+
+func dataSourceFabricCloudRouterPackages() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFabricCloudRouterPackagesRead,
+		Schema:      readFabricCloudRouterPackagesSchema(),
+		Description: `Fabric V4 API compatible data resource that allow user to fetch all Fabric Cloud Router Packages
+
+Additional documentation:
+* Getting Started: https://docs.equinix.com/fabric-cloud-router/
+* API: https://docs.equinix.com/api-catalog/fabricv4/#tag/Cloud-Routers`,
+	}
+}
+
+func dataSourceFabricCloudRouterPackagesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
+	packages, err := getFabricCloudRouterPackages(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	mappedPackages := make([]map[string]any, len(packages))
+	for index, cloudRouterPackage := range packages {
+		mappedPackages[index] = fabricCloudRouterPackageMap(&cloudRouterPackage)
+	}
+	d.SetId("cloud_router_packages")
+	if err := equinix_schema.SetMap(d, map[string]any{"data": mappedPackages}); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// getFabricCloudRouterPackages retrieves the Cloud Router package catalog one
+// page at a time
+func getFabricCloudRouterPackages(ctx context.Context, client *fabricv4.APIClient) ([]fabricv4.CloudRouterPackage, error) {
+	var packages []fabricv4.CloudRouterPackage
+	for offset := int32(0); ; offset += fabricCloudRouterPackagesPageSize {
+		page, _, err := client.CloudRoutersApi.GetCloudRouterPackages(ctx).
+			Offset(offset).Limit(fabricCloudRouterPackagesPageSize).Execute()
+		if err != nil {
+			return nil, equinix_errors.FormatFabricError(err)
+		}
+		packages = append(packages, page.GetData()...)
+		if len(page.GetData()) < fabricCloudRouterPackagesPageSize ||
+			int32(len(packages)) >= page.Pagination.GetTotal() {
+			return packages, nil
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package equinix
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/equinix/terraform-provider-equinix/internal/config"
+	"github.com/equinix/terraform-provider-equinix/internal/converters"
+	"github.com/equinix/terraform-provider-equinix/internal/network"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var networkDeviceBackupsSchemaNames = map[string]string{
+	"DeviceUUID": "device_id",
+	"Statuses":   "statuses",
+	"Backups":    "backups",
+}
+
+var networkDeviceBackupsDescriptions = map[string]string{
+	"DeviceUUID": "Unique identifier of the device to list backups for",
+	"Statuses":   "Limits returned backups to those with given statuses",
+	"Backups":    "List of device backups",
+}
+
+func dataSourceNetworkDeviceBackups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkDeviceBackupsRead,
+		Description: "Use this data source to list Equinix Network Edge device configuration backups.",
+		Schema: map[string]*schema.Schema{
+			networkDeviceBackupsSchemaNames["DeviceUUID"]: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  networkDeviceBackupsDescriptions["DeviceUUID"],
+			},
+			networkDeviceBackupsSchemaNames["Statuses"]: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{network.DeviceBackupStatusPending, network.DeviceBackupStatusCompleted, network.DeviceBackupStatusFailed, network.DeviceBackupStatusDeleted}, false),
+				},
+				Description: networkDeviceBackupsDescriptions["Statuses"],
+			},
+			networkDeviceBackupsSchemaNames["Backups"]: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: createNetworkDeviceBackupSchema(),
+				},
+				Description: networkDeviceBackupsDescriptions["Backups"],
+			},
+		},
+	}
+}
+
+func dataSourceNetworkDeviceBackupsRead(_ context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*config.Config).Ne
+	var diags diag.Diagnostics
+	neClient, err := network.NewClient(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	deviceID := d.Get(networkDeviceBackupsSchemaNames["DeviceUUID"]).(string)
+	statuses := converters.IfArrToStringArr(d.Get(networkDeviceBackupsSchemaNames["Statuses"]).([]any))
+	backups, err := neClient.GetDeviceBackups(deviceID, statuses)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s-%s", deviceID, strings.Join(statuses, "-")))
+	if err := d.Set(networkDeviceBackupsSchemaNames["Backups"], flattenNetworkDeviceBackups(backups)); err != nil {
+		return diag.Errorf("error reading Backups: %s", err)
+	}
+	return diags
+}
+
+func flattenNetworkDeviceBackups(backups []network.DeviceBackup) []any {
+	transformed := make([]any, len(backups))
+	for i := range backups {
+		transformed[i] = flattenNetworkDeviceBackup(&backups[i])
+	}
+	return transformed
+}
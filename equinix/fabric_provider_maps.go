@@ -9,44 +9,58 @@ import (
 	fabric_route_filter_rule "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/route_filter_rule"
 	fabric_service_token "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/servicetoken"
 
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func fabricDatasources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"equinix_fabric_routing_protocol":          dataSourceRoutingProtocol(),
-		"equinix_fabric_connection":                fabric_connection.DataSource(),
-		"equinix_fabric_connections":               fabric_connection.DataSourceSearch(),
-		"equinix_fabric_connection_route_filter":   fabric_connection_route_filter.DataSource(),
-		"equinix_fabric_connection_route_filters":  fabric_connection_route_filter.DataSourceGetAllRules(),
-		"equinix_fabric_cloud_router":              dataSourceFabricCloudRouter(),
-		"equinix_fabric_cloud_routers":             dataSourceFabricGetCloudRouters(),
-		"equinix_fabric_market_place_subscription": fabric_market_place_subscription.DataSourceFabricMarketplaceSubscription(),
-		"equinix_fabric_network":                   fabric_network.DataSource(),
-		"equinix_fabric_networks":                  fabric_network.DataSourceSearch(),
-		"equinix_fabric_port":                      dataSourceFabricPort(),
-		"equinix_fabric_ports":                     dataSourceFabricGetPortsByName(),
-		"equinix_fabric_route_filter":              fabric_route_filter.DataSource(),
-		"equinix_fabric_route_filters":             fabric_route_filter.DataSourceSearch(),
-		"equinix_fabric_route_filter_rule":         fabric_route_filter_rule.DataSource(),
-		"equinix_fabric_route_filter_rules":        fabric_route_filter_rule.DataSourceGetRules(),
-		"equinix_fabric_service_profile":           dataSourceFabricServiceProfileReadByUUID(),
-		"equinix_fabric_service_profiles":          dataSourceFabricSearchServiceProfilesByName(),
-		"equinix_fabric_service_token":             fabric_service_token.DataSource(),
-		"equinix_fabric_service_tokens":            fabric_service_token.DataSourceSearch(),
+		"equinix_fabric_routing_protocol":           dataSourceRoutingProtocol(),
+		"equinix_fabric_connection":                 fabric_connection.DataSource(),
+		"equinix_fabric_connections":                fabric_connection.DataSourceSearch(),
+		"equinix_fabric_connection_route_filter":    fabric_connection_route_filter.DataSource(),
+		"equinix_fabric_connection_route_filters":   fabric_connection_route_filter.DataSourceGetAllRules(),
+		"equinix_fabric_cloud_router":               dataSourceFabricCloudRouter(),
+		"equinix_fabric_cloud_routers":              dataSourceFabricGetCloudRouters(),
+		"equinix_fabric_cloud_router_package":       dataSourceFabricCloudRouterPackage(),
+		"equinix_fabric_cloud_router_packages":      dataSourceFabricCloudRouterPackages(),
+		"equinix_fabric_market_place_subscription":  fabric_market_place_subscription.DataSourceFabricMarketplaceSubscription(),
+		"equinix_fabric_market_place_subscriptions": fabric_market_place_subscription.DataSourceFabricMarketplaceSubscriptions(),
+		"equinix_fabric_network":                    fabric_network.DataSource(),
+		"equinix_fabric_network_changes":            fabric_network.DataSourceChanges(),
+		"equinix_fabric_network_connections":        fabric_network.DataSourceConnections(),
+		"equinix_fabric_networks":                   fabric_network.DataSourceSearch(),
+		"equinix_fabric_port":                       dataSourceFabricPort(),
+		"equinix_fabric_ports":                      dataSourceFabricGetPortsByName(),
+		"equinix_fabric_route_filter":               fabric_route_filter.DataSource(),
+		"equinix_fabric_route_filters":              fabric_route_filter.DataSourceSearch(),
+		"equinix_fabric_route_filter_rule":          fabric_route_filter_rule.DataSource(),
+		"equinix_fabric_route_filter_rules":         fabric_route_filter_rule.DataSourceGetRules(),
+		"equinix_fabric_service_profile":            dataSourceFabricServiceProfileReadByUUID(),
+		"equinix_fabric_service_profiles":           dataSourceFabricSearchServiceProfilesByName(),
+		"equinix_fabric_service_token":              fabric_service_token.DataSource(),
+		"equinix_fabric_service_tokens":             fabric_service_token.DataSourceSearch(),
 	}
 }
 
 func fabricResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"equinix_fabric_network":                 fabric_network.Resource(),
-		"equinix_fabric_cloud_router":            resourceFabricCloudRouter(),
-		"equinix_fabric_connection":              fabric_connection.Resource(),
-		"equinix_fabric_connection_route_filter": fabric_connection_route_filter.Resource(),
-		"equinix_fabric_route_filter":            fabric_route_filter.Resource(),
-		"equinix_fabric_route_filter_rule":       fabric_route_filter_rule.Resource(),
-		"equinix_fabric_routing_protocol":        resourceFabricRoutingProtocol(),
-		"equinix_fabric_service_profile":         resourceFabricServiceProfile(),
-		"equinix_fabric_service_token":           fabric_service_token.Resource(),
+		"equinix_fabric_network":                     fabric_network.Resource(),
+		"equinix_fabric_cloud_router":                resourceFabricCloudRouter(),
+		"equinix_fabric_connection":                  fabric_connection.Resource(),
+		"equinix_fabric_connection_bandwidth_change": fabric_connection.BandwidthChangeResource(),
+		"equinix_fabric_connection_route_filter":     fabric_connection_route_filter.Resource(),
+		"equinix_fabric_redundant_connection":        fabric_connection.RedundantResource(),
+		"equinix_fabric_route_filter":                fabric_route_filter.Resource(),
+		"equinix_fabric_route_filter_rule":           fabric_route_filter_rule.Resource(),
+		"equinix_fabric_routing_protocol":            resourceFabricRoutingProtocol(),
+		"equinix_fabric_service_profile":             resourceFabricServiceProfile(),
+		"equinix_fabric_service_token":               fabric_service_token.Resource(),
+	}
+}
+
+func fabricListResources() []func() list.ListResource {
+	return []func() list.ListResource{
+		newFabricCloudRouterListResource,
 	}
 }
@@ -0,0 +1,148 @@
+package equinix
+
+import (
+	"context"
+	"fmt"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// fabricCloudRouterListStates are the cloud router states listed when no
+// states are given, which leave out deprovisioned cloud routers
+var fabricCloudRouterListStates = []string{
+	string(fabricv4.CLOUDROUTERACCESSPOINTSTATE_PROVISIONED),
+	string(fabricv4.CLOUDROUTERACCESSPOINTSTATE_PROVISIONING),
+	string(fabricv4.CLOUDROUTERACCESSPOINTSTATE_DEPROVISIONING),
+	string(fabricv4.CLOUDROUTERACCESSPOINTSTATE_REPROVISIONING),
+	string(fabricv4.CLOUDROUTERACCESSPOINTSTATE_NOT_PROVISIONED),
+	string(fabricv4.CLOUDROUTERACCESSPOINTSTATE_NOT_DEPROVISIONED),
+}
+
+func newFabricCloudRouterListResource() list.ListResource {
+	return &fabricCloudRouterListResource{
+		SDKv2ListResource: framework.NewSDKv2ListResource(
+			framework.BaseListResourceConfig{
+				Name: "equinix_fabric_cloud_router",
+			},
+			resourceFabricCloudRouter(),
+		),
+	}
+}
+
+type fabricCloudRouterListResource struct {
+	framework.SDKv2ListResource
+}
+
+type fabricCloudRouterListModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	Name      types.String `tfsdk:"name"`
+	States    types.List   `tfsdk:"states"`
+}
+
+func (r *fabricCloudRouterListResource) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Fabric Cloud Routers matching the given filters",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "Project ID the Cloud Routers belong to",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the Cloud Routers",
+				Optional:    true,
+			},
+			"states": schema.ListAttribute{
+				Description: fmt.Sprintf("States of the Cloud Routers. Defaults to all states except DEPROVISIONED. One of %v", fabricv4.AllowedCloudRouterAccessPointStateEnumValues),
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(fabricCloudRouterStateValues()...)),
+				},
+			},
+		},
+	}
+}
+
+func (r *fabricCloudRouterListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var filters fabricCloudRouterListModel
+	diags := req.Config.Get(ctx, &filters)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	states := fabricCloudRouterListStates
+	if !filters.States.IsNull() {
+		states = nil
+		diags.Append(filters.States.ElementsAs(ctx, &states, false)...)
+		if diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	expressions := []fabricv4.CloudRouterFilter{
+		fabricCloudRouterListFilter("/state", fabricv4.EXPRESSIONOPERATOR_IN, states...),
+	}
+	if projectID := filters.ProjectID.ValueString(); projectID != "" {
+		expressions = append(expressions, fabricCloudRouterListFilter("/project/projectId", fabricv4.EXPRESSIONOPERATOR_EQUAL, projectID))
+	}
+	if name := filters.Name.ValueString(); name != "" {
+		expressions = append(expressions, fabricCloudRouterListFilter("/name", fabricv4.EXPRESSIONOPERATOR_EQUAL, name))
+	}
+	filter := fabricv4.CloudRouterFilters{}
+	filter.SetAnd(expressions)
+
+	client := r.Meta.NewFabricClientForList(ctx)
+	items := framework.Paginate(func(offset int32) ([]framework.ListItem, bool, error) {
+		pagination := fabricv4.PaginationRequest{}
+		pagination.SetOffset(offset)
+		pagination.SetLimit(framework.ListPageSize)
+		searchRequest := fabricv4.CloudRouterSearchRequest{}
+		searchRequest.SetFilter(filter)
+		searchRequest.SetPagination(pagination)
+		cloudRouters, _, err := client.CloudRoutersApi.SearchCloudRouters(ctx).CloudRouterSearchRequest(searchRequest).Execute()
+		if err != nil {
+			return nil, false, equinix_errors.FormatFabricError(err)
+		}
+		items := make([]framework.ListItem, len(cloudRouters.GetData()))
+		for i, cloudRouter := range cloudRouters.GetData() {
+			items[i] = framework.ListItem{ID: cloudRouter.GetUuid(), DisplayName: cloudRouter.GetName()}
+		}
+		return items, framework.HasMorePages(offset, len(items), cloudRouters.Pagination.GetTotal()), nil
+	})
+	stream.Results = r.Results(ctx, req, items)
+}
+
+func fabricCloudRouterStateValues() []string {
+	values := make([]string, len(fabricv4.AllowedCloudRouterAccessPointStateEnumValues))
+	for i, state := range fabricv4.AllowedCloudRouterAccessPointStateEnumValues {
+		values[i] = string(state)
+	}
+	return values
+}
+
+func fabricCloudRouterListFilter(property string, operator fabricv4.ExpressionOperator, values ...string) fabricv4.CloudRouterFilter {
+	expression := fabricv4.CloudRouterSimpleExpression{}
+	expression.SetProperty(property)
+	expression.SetOperator(string(operator))
+	expression.SetValues(values)
+	return fabricv4.CloudRouterFilter{CloudRouterSimpleExpression: &expression}
+}
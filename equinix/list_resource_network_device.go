@@ -0,0 +1,118 @@
+package equinix
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/equinix/ne-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func newNetworkDeviceListResource() list.ListResource {
+	return &networkDeviceListResource{
+		SDKv2ListResource: framework.NewSDKv2ListResource(
+			framework.BaseListResourceConfig{
+				Name: "equinix_network_device",
+			},
+			resourceNetworkDevice(),
+		),
+	}
+}
+
+type networkDeviceListResource struct {
+	framework.SDKv2ListResource
+}
+
+type networkDeviceListModel struct {
+	Name     types.String `tfsdk:"name"`
+	Statuses types.List   `tfsdk:"statuses"`
+}
+
+func (r *networkDeviceListResource) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Network Edge devices matching the given filters. Secondary devices of redundant pairs are managed with their primary device and are not listed",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of the devices",
+				Optional:    true,
+			},
+			"statuses": schema.ListAttribute{
+				Description: fmt.Sprintf("Statuses of the devices. Defaults to all statuses except DEPROVISIONED. One of %v", networkDeviceStatusValues()),
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(networkDeviceStatusValues()...)),
+				},
+			},
+		},
+	}
+}
+
+func (r *networkDeviceListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var filters networkDeviceListModel
+	diags := req.Config.Get(ctx, &filters)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	statuses := slices.DeleteFunc(networkDeviceStatusValues(), func(status string) bool {
+		return status == ne.DeviceStateDeprovisioned
+	})
+	if !filters.Statuses.IsNull() {
+		statuses = nil
+		diags.Append(filters.Statuses.ElementsAs(ctx, &statuses, false)...)
+		if diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+	name := filters.Name.ValueString()
+
+	// The Network Edge client fetches all pages of devices at once
+	items := func(yield func(framework.ListItem, error) bool) {
+		devices, err := r.Meta.Ne.GetDevices(statuses)
+		if err != nil {
+			yield(framework.ListItem{}, err)
+			return
+		}
+		for _, device := range devices {
+			if ne.StringValue(device.RedundancyType) == "SECONDARY" {
+				continue
+			}
+			if name != "" && ne.StringValue(device.Name) != name {
+				continue
+			}
+			item := framework.ListItem{ID: ne.StringValue(device.UUID), DisplayName: ne.StringValue(device.Name)}
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+	stream.Results = r.Results(ctx, req, items)
+}
+
+func networkDeviceStatusValues() []string {
+	values := make([]string, 0, len(neDeviceStateMap))
+	for _, status := range neDeviceStateMap {
+		values = append(values, status)
+	}
+	slices.Sort(values)
+	return values
+}
@@ -0,0 +1,83 @@
+package equinix
+
+import (
+	"context"
+	"testing"
+
+	"github.com/equinix/terraform-provider-equinix/internal/importer"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkEdgeResources_identity(t *testing.T) {
+	for name, r := range networkEdgeResources() {
+		if r.Importer == nil {
+			continue
+		}
+		t.Run(name, func(t *testing.T) {
+			// given
+			require.NotNil(t, r.Identity, "Importable Network Edge resources have an identity schema")
+			d := r.TestResourceData()
+			identity, err := d.Identity()
+			require.NoError(t, err)
+			for attr := range r.Identity.SchemaMap() {
+				require.NoError(t, identity.Set(attr, attr+"-value"))
+			}
+
+			// when
+			imported, err := r.Importer.StateContext(context.Background(), d, nil)
+
+			// then
+			require.NoError(t, err, "Network Edge resources can be imported by identity")
+			require.Len(t, imported, 1)
+			for attr := range r.Identity.SchemaMap() {
+				if attr == importer.IdentityUUID {
+					assert.Equal(t, attr+"-value", imported[0].Id(), "Resource ID is taken from identity")
+					continue
+				}
+				assert.Equal(t, attr+"-value", imported[0].Get(attr), "Attribute is taken from identity")
+			}
+		})
+	}
+}
+
+func TestNetworkEdgeResources_uuidIdentity(t *testing.T) {
+	for name, r := range networkEdgeResources() {
+		if r.Identity == nil {
+			continue
+		}
+		if _, ok := r.Identity.SchemaMap()[importer.IdentityUUID]; !ok {
+			continue
+		}
+		t.Run(name, func(t *testing.T) {
+			// given
+			d := r.TestResourceData()
+			d.SetId("resource-uuid")
+
+			// when
+			err := importer.SetUUIDIdentity(d)
+
+			// then
+			require.NoError(t, err)
+			identity, err := d.Identity()
+			require.NoError(t, err)
+			assert.Equal(t, "resource-uuid", identity.Get(importer.IdentityUUID), "Identity UUID matches resource ID")
+		})
+	}
+}
+
+func TestNetworkResourceImporter_identity(t *testing.T) {
+	// given
+	d := resourceNetworkSSHKey().TestResourceData()
+	identity, err := d.Identity()
+	require.NoError(t, err)
+	require.NoError(t, identity.Set(importer.IdentityUUID, "key-uuid"))
+
+	// when
+	imported, err := importer.UUIDImporter().StateContext(context.Background(), d, nil)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "key-uuid", imported[0].Id(), "Resource ID is taken from identity")
+}
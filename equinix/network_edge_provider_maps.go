@@ -1,6 +1,7 @@
 package equinix
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -11,17 +12,28 @@ func networkEdgeDatasources() map[string]*schema.Resource {
 		"equinix_network_device_type":     dataSourceNetworkDeviceType(),
 		"equinix_network_device_software": dataSourceNetworkDeviceSoftware(),
 		"equinix_network_device_platform": dataSourceNetworkDevicePlatform(),
+		"equinix_network_device_backups":  dataSourceNetworkDeviceBackups(),
 	}
 }
 
 func networkEdgeResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"equinix_network_device":       resourceNetworkDevice(),
-		"equinix_network_ssh_user":     resourceNetworkSSHUser(),
-		"equinix_network_bgp":          resourceNetworkBGP(),
-		"equinix_network_ssh_key":      resourceNetworkSSHKey(),
-		"equinix_network_acl_template": resourceNetworkACLTemplate(),
-		"equinix_network_device_link":  resourceNetworkDeviceLink(),
-		"equinix_network_file":         resourceNetworkFile(),
+		"equinix_network_device":                resourceNetworkDevice(),
+		"equinix_network_ssh_user":              resourceNetworkSSHUser(),
+		"equinix_network_ssh_user_device":       resourceNetworkSSHUserDevice(),
+		"equinix_network_bgp":                   resourceNetworkBGP(),
+		"equinix_network_ssh_key":               resourceNetworkSSHKey(),
+		"equinix_network_acl_template":          resourceNetworkACLTemplate(),
+		"equinix_network_device_link":           resourceNetworkDeviceLink(),
+		"equinix_network_device_action":         resourceNetworkDeviceAction(),
+		"equinix_network_device_acl_assignment": resourceNetworkDeviceACLAssignment(),
+		"equinix_network_device_backup":         resourceNetworkDeviceBackup(),
+		"equinix_network_file":                  resourceNetworkFile(),
+	}
+}
+
+func networkEdgeListResources() []func() list.ListResource {
+	return []func() list.ListResource{
+		newNetworkDeviceListResource,
 	}
 }
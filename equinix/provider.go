@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/equinix/terraform-provider-equinix/internal/config"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -99,6 +100,24 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc(config.TokenExchangeSubjectTokenEnvVarEnvVar, config.DefaultTokenExchangeSubjectTokenEnvVar),
 				Description: fmt.Sprintf("The name of the environment variable containing the subject token for token exchange. This argument can also be specified with the `EQUINIX_TOKEN_EXCHANGE_SUBJECT_TOKEN_ENV_VAR` shell environment variable. (Defaults to `%s`). Please note that token exchange is an alpha feature and not available for all users.", config.DefaultTokenExchangeSubjectTokenEnvVar),
 			},
+			"token_exchange_subject_token_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(config.TokenExchangeSubjectTokenFileEnvVar, ""),
+				Description: "Path to a file containing the subject token for token exchange, such as a Kubernetes projected service account token. The file is re-read whenever a new token exchange is needed, so rotated tokens are picked up during long applies. Takes precedence over `token_exchange_github_actions_oidc` and `token_exchange_subject_token_env_var`. This argument can also be specified with the `EQUINIX_TOKEN_EXCHANGE_SUBJECT_TOKEN_FILE` shell environment variable. Please note that token exchange is an alpha feature and not available for all users.",
+			},
+			"token_exchange_github_actions_oidc": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(config.TokenExchangeGitHubActionsOIDCEnvVar, false),
+				Description: "Request the subject token for token exchange from the GitHub Actions OIDC provider using the `ACTIONS_ID_TOKEN_REQUEST_URL` and `ACTIONS_ID_TOKEN_REQUEST_TOKEN` environment variables. A fresh token is requested whenever a new token exchange is needed. Takes precedence over `token_exchange_subject_token_env_var`. This argument can also be specified with the `EQUINIX_TOKEN_EXCHANGE_GITHUB_ACTIONS_OIDC` shell environment variable. Please note that token exchange is an alpha feature and not available for all users.",
+			},
+			"token_exchange_github_actions_audience": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(config.TokenExchangeGitHubAudienceEnvVar, ""),
+				Description: "The audience to request when `token_exchange_github_actions_oidc` is enabled. This argument can also be specified with the `EQUINIX_TOKEN_EXCHANGE_GITHUB_ACTIONS_AUDIENCE` shell environment variable. Please note that token exchange is an alpha feature and not available for all users.",
+			},
 		},
 		DataSourcesMap: datasources,
 		ResourcesMap:   resources,
@@ -137,6 +156,9 @@ func configureProvider(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		StsBaseURL:                      d.Get("sts_endpoint").(string),
 		TokenExchangeSubjectToken:       d.Get("token_exchange_subject_token").(string),
 		TokenExchangeSubjectTokenEnvVar: d.Get("token_exchange_subject_token_env_var").(string),
+		TokenExchangeSubjectTokenFile:   d.Get("token_exchange_subject_token_file").(string),
+		TokenExchangeGitHubActionsOIDC:  d.Get("token_exchange_github_actions_oidc").(bool),
+		TokenExchangeGitHubAudience:     d.Get("token_exchange_github_actions_audience").(string),
 	}
 	meta := providerMeta{}
 
@@ -164,3 +186,13 @@ func configureProvider(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 	}
 	return &config, nil
 }
+
+// ListResources returns the list resources of the SDKv2 resources, which are
+// served by the framework provider
+func ListResources() []func() list.ListResource {
+	listResources := []func() list.ListResource{}
+	listResources = append(listResources, fabricListResources()...)
+	listResources = append(listResources, networkEdgeListResources()...)
+
+	return listResources
+}
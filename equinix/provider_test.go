@@ -67,7 +67,7 @@ func init() {
 	}
 	// during framework migration, it is required to duplicate this (TestAccFrameworkProvider declared in internal package)
 	// for e2e tests that need already migrated resources. Importing from internal produces and import cycle error
-	testAccFrameworkProvider = provider.CreateFrameworkProvider(version.ProviderVersion).(*provider.FrameworkProvider)
+	testAccFrameworkProvider = provider.CreateFrameworkProvider(version.ProviderVersion, ListResources()...).(*provider.FrameworkProvider)
 }
 
 func TestProvider(t *testing.T) {
@@ -6,19 +6,21 @@ import (
 	"log"
 	"reflect"
 	"sort"
-	"strings"
 	"time"
 
 	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
 	equinix_fabric_schema "github.com/equinix/terraform-provider-equinix/internal/fabric/schema"
+	fabric_market_place_subscription "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/marketplace"
 	equinix_schema "github.com/equinix/terraform-provider-equinix/internal/schema"
 
 	"github.com/equinix/terraform-provider-equinix/internal/config"
+	"github.com/equinix/terraform-provider-equinix/internal/importer"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
@@ -208,10 +210,17 @@ func resourceFabricCloudRouter() *schema.Resource {
 		CreateContext: resourceFabricCloudRouterCreate,
 		UpdateContext: resourceFabricCloudRouterUpdate,
 		DeleteContext: resourceFabricCloudRouterDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Schema: fabricCloudRouterResourceSchema(),
+		Importer:      importer.UUIDImporter(),
+		Identity:      importer.UUIDIdentity(),
+		Schema:        fabricCloudRouterResourceSchema(),
+		CustomizeDiff: customdiff.All(
+			customdiff.IfValueChange("package", func(_ context.Context, _, newValue, _ any) bool {
+				return newValue.(*schema.Set).Len() > 0
+			}, validateFabricCloudRouterPackage),
+			customdiff.IfValueChange("marketplace_subscription", func(_ context.Context, _, newValue, _ any) bool {
+				return newValue.(*schema.Set).Len() > 0
+			}, fabric_market_place_subscription.ValidateSubscriptionDiff),
+		),
 
 		Description: `Fabric V4 API compatible resource allows creation and management of [Equinix Fabric Cloud Router](https://docs.equinix.com/fabric-cloud-router/).
 
@@ -221,6 +230,37 @@ Additional documentation:
 	}
 }
 
+// validateFabricCloudRouterPackage ensures that the requested package code is
+// offered in the Cloud Router package catalog before a change is planned
+func validateFabricCloudRouterPackage(ctx context.Context, d *schema.ResourceDiff, m any) error {
+	if !d.NewValueKnown("package") {
+		return nil
+	}
+	schemaPackage := d.Get("package").(*schema.Set).List()
+	cloudRouterPackage := packageCloudRouterTerraformToGo(schemaPackage)
+	code := string(cloudRouterPackage.GetCode())
+	if code == "" {
+		return nil
+	}
+	client := m.(*config.Config).NewFabricClientForSDKPlan(ctx)
+	packages, err := getFabricCloudRouterPackages(ctx, client)
+	if err != nil {
+		return fmt.Errorf("cannot validate cloud router package %q: %w", code, err)
+	}
+	return validateFabricCloudRouterPackageCode(packages, code)
+}
+
+func validateFabricCloudRouterPackageCode(packages []fabricv4.CloudRouterPackage, code string) error {
+	codes := make([]string, len(packages))
+	for i, cloudRouterPackage := range packages {
+		codes[i] = string(cloudRouterPackage.GetCode())
+		if codes[i] == code {
+			return nil
+		}
+	}
+	return fmt.Errorf("cloud router package %q is not available, available packages: %v, see the equinix_fabric_cloud_router_packages data source", code, codes)
+}
+
 func accountCloudRouterTerraformToGo(accountList []any) fabricv4.SimplifiedAccount {
 	if len(accountList) == 0 {
 		return fabricv4.SimplifiedAccount{}
@@ -330,16 +370,21 @@ func resourceFabricCloudRouterCreate(ctx context.Context, d *schema.ResourceData
 
 func resourceFabricCloudRouterRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
-	cloudRouter, _, err := client.CloudRoutersApi.GetCloudRouterByUuid(ctx, d.Id()).Execute()
+	cloudRouter, httpResp, err := client.CloudRoutersApi.GetCloudRouterByUuid(ctx, d.Id()).Execute()
+	if err == nil {
+		err = equinix_errors.CheckFabricDeletedState(d.Id(), string(cloudRouter.GetState()))
+	}
 	if err != nil {
-		log.Printf("[WARN] Fabric Cloud Router %s not found , error %s", d.Id(), err)
-		if !strings.Contains(err.Error(), "500") {
-			d.SetId("")
-		}
-		return diag.FromErr(equinix_errors.FormatFabricError(err))
+		return equinix_errors.FabricReadDiagnostics(d, httpResp, err)
 	}
 	d.SetId(cloudRouter.GetUuid())
-	return setCloudRouterMap(d, cloudRouter)
+	if diags := setCloudRouterMap(d, cloudRouter); diags.HasError() {
+		return diags
+	}
+	if err := importer.SetUUIDIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
 func fabricCloudRouterMap(fcr *fabricv4.CloudRouter) map[string]any {
@@ -0,0 +1,23 @@
+package equinix
+
+import (
+	"testing"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFabricCloudRouter_validatePackageCode(t *testing.T) {
+	// given
+	packages := []fabricv4.CloudRouterPackage{
+		{Code: fabricv4.CODE_STANDARD.Ptr()},
+		{Code: fabricv4.CODE_PREMIUM.Ptr()},
+	}
+	// when
+	validErr := validateFabricCloudRouterPackageCode(packages, "PREMIUM")
+	missingErr := validateFabricCloudRouterPackageCode(packages, "LAB")
+	// then
+	assert.Nil(t, validErr, "Package in the catalog passes validation")
+	assert.ErrorContains(t, missingErr, "is not available", "Package missing from the catalog fails validation")
+	assert.ErrorContains(t, missingErr, "[STANDARD PREMIUM]", "Available packages are listed in the error")
+}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"log"
 	"runtime/debug"
-	"strings"
 
 	"github.com/equinix/equinix-sdk-go/services/fabricv4"
 
@@ -383,10 +382,10 @@ func portEncapsulationGoToTerraform(portEncapsulation *fabricv4.PortEncapsulatio
 
 func resourceFabricPortRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
-	port, _, err := client.PortsApi.GetPortByUuid(ctx, d.Id()).Execute()
+	port, httpResp, err := client.PortsApi.GetPortByUuid(ctx, d.Id()).Execute()
 	if err != nil {
 		log.Printf("[WARN] Port %s not found , error %s", d.Id(), err)
-		if !strings.Contains(err.Error(), "500") {
+		if equinix_errors.IsFabricNotFound(httpResp, err) {
 			d.SetId("")
 		}
 		return diag.FromErr(equinix_errors.FormatFabricError(err))
@@ -514,10 +513,10 @@ func resourceFabricPortGetByPortName(ctx context.Context, d *schema.ResourceData
 		if f["operator"] == "=" {
 			switch f["property"] {
 			case "/uuid":
-				port, _, err := client.PortsApi.GetPortByUuid(ctx, f["value"]).Execute()
+				port, httpResp, err := client.PortsApi.GetPortByUuid(ctx, f["value"]).Execute()
 				if err != nil {
 					log.Printf("[WARN] Port uuid %s not found , error %s", f["value"], err)
-					if !strings.Contains(err.Error(), "500") {
+					if equinix_errors.IsFabricNotFound(httpResp, err) {
 						d.SetId("")
 					}
 					return diag.FromErr(equinix_errors.FormatFabricError(err))
@@ -526,10 +525,10 @@ func resourceFabricPortGetByPortName(ctx context.Context, d *schema.ResourceData
 				d.SetId(port.GetUuid())
 				return setPortsListMap(d, ports)
 			case "/name":
-				ports, _, err := client.PortsApi.GetPorts(ctx).Name(f["value"]).Execute()
+				ports, httpResp, err := client.PortsApi.GetPorts(ctx).Name(f["value"]).Execute()
 				if err != nil {
 					log.Printf("[WARN] Ports not found , error %s", err)
-					if !strings.Contains(err.Error(), "500") {
+					if equinix_errors.IsFabricNotFound(httpResp, err) {
 						d.SetId("")
 					}
 					return diag.FromErr(equinix_errors.FormatFabricError(err))
@@ -6,7 +6,6 @@ import (
 	"log"
 	"reflect"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/equinix/equinix-sdk-go/services/fabricv4"
@@ -14,6 +13,7 @@ import (
 
 	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
 	equinix_fabric_schema "github.com/equinix/terraform-provider-equinix/internal/fabric/schema"
+	"github.com/equinix/terraform-provider-equinix/internal/importer"
 	equinix_schema "github.com/equinix/terraform-provider-equinix/internal/schema"
 
 	"github.com/equinix/terraform-provider-equinix/internal/config"
@@ -320,18 +320,8 @@ func resourceFabricRoutingProtocol() *schema.Resource {
 		UpdateContext: resourceFabricRoutingProtocolUpdate,
 		DeleteContext: resourceFabricRoutingProtocolDelete,
 		Importer: &schema.ResourceImporter{
-			// Custom state context function, to parse import argument as  connection_uuid/rp_uuid
-			StateContext: func(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
-				parts := strings.SplitN(d.Id(), "/", 2)
-				if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-					return nil, fmt.Errorf("unexpected format of ID (%s), expected <conn-uuid>/<rp-uuid>", d.Id())
-				}
-				connectionUUID, uuid := parts[0], parts[1]
-				// set set connection uuid and rp uuid as overall id of resource
-				_ = d.Set("connection_uuid", connectionUUID)
-				d.SetId(uuid)
-				return []*schema.ResourceData{d}, nil
-			},
+			// Parse import argument as connection_uuid/rp_uuid
+			StateContext: importer.StateContext("connection_uuid"),
 		},
 		Schema: createFabricRoutingProtocolResourceSchema(),
 
@@ -342,13 +332,9 @@ func resourceFabricRoutingProtocol() *schema.Resource {
 func resourceFabricRoutingProtocolRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
 	log.Printf("[WARN] Routing Protocol Connection uuid: %s", d.Get("connection_uuid").(string))
-	fabricRoutingProtocolData, _, err := client.RoutingProtocolsApi.GetConnectionRoutingProtocolByUuid(ctx, d.Id(), d.Get("connection_uuid").(string)).Execute()
+	fabricRoutingProtocolData, httpResp, err := client.RoutingProtocolsApi.GetConnectionRoutingProtocolByUuid(ctx, d.Id(), d.Get("connection_uuid").(string)).Execute()
 	if err != nil {
-		log.Printf("[WARN] Routing Protocol %s not found , error %s", d.Id(), err)
-		if !strings.Contains(err.Error(), "500") {
-			d.SetId("")
-		}
-		return diag.FromErr(equinix_errors.FormatFabricError(err))
+		return equinix_errors.FabricReadDiagnostics(d, httpResp, err)
 	}
 
 	_ = setIDFromAPIResponse(fabricRoutingProtocolData, false, d)
@@ -404,9 +390,6 @@ func resourceFabricRoutingProtocolUpdate(ctx context.Context, d *schema.Resource
 	updateTimeout := d.Timeout(schema.TimeoutUpdate) - 30*time.Second - time.Since(start)
 	_, err = waitForRoutingProtocolUpdateCompletion(ctx, changeUUID, d.Id(), d.Get("connection_uuid").(string), meta, d, updateTimeout)
 	if err != nil {
-		if !strings.Contains(err.Error(), "500") {
-			d.SetId("")
-		}
 		return diag.FromErr(fmt.Errorf("timeout updating routing protocol: %v", err))
 	}
 
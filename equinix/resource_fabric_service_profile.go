@@ -560,12 +560,12 @@ Additional documentation:
 
 func resourceFabricServiceProfileRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
-	serviceProfile, _, err := client.ServiceProfilesApi.GetServiceProfileByUuid(ctx, d.Id()).Execute()
+	serviceProfile, httpResp, err := client.ServiceProfilesApi.GetServiceProfileByUuid(ctx, d.Id()).Execute()
+	if err == nil {
+		err = equinix_errors.CheckFabricDeletedState(d.Id(), string(serviceProfile.GetState()))
+	}
 	if err != nil {
-		if !strings.Contains(err.Error(), "500") {
-			d.SetId("")
-		}
-		return diag.FromErr(equinix_errors.FormatFabricError(err))
+		return equinix_errors.FabricReadDiagnostics(d, httpResp, err)
 	}
 	d.SetId(serviceProfile.GetUuid())
 	return setFabricServiceProfileMap(d, serviceProfile)
@@ -658,9 +658,6 @@ func resourceFabricServiceProfileUpdate(ctx context.Context, d *schema.ResourceD
 	var eTag int64
 	_, eTag, err = waitForActiveServiceProfileAndPopulateETag(ctx, meta, d, uuid, updateTimeout)
 	if err != nil {
-		if !strings.Contains(err.Error(), "500") {
-			d.SetId("")
-		}
 		return diag.Errorf("Either timed out or errored out while fetching service profile for uuid %s and error %v", uuid, err)
 	}
 	_, _, err = client.ServiceProfilesApi.PutServiceProfileByUuid(ctx, uuid).IfMatch(strconv.FormatInt(eTag, 10)).ServiceProfileRequest(updateRequest).Execute()
@@ -672,9 +669,6 @@ func resourceFabricServiceProfileUpdate(ctx context.Context, d *schema.ResourceD
 	var updatedServiceProfile *fabricv4.ServiceProfile
 	updatedServiceProfile, err = waitForServiceProfileUpdateCompletion(ctx, meta, d, uuid, updateTimeout)
 	if err != nil {
-		if !strings.Contains(err.Error(), "500") {
-			d.SetId("")
-		}
 		return diag.FromErr(fmt.Errorf("errored while waiting for successful service profile update, error %v", err))
 	}
 	d.SetId(updatedServiceProfile.GetUuid())
@@ -883,9 +877,9 @@ func resourceServiceProfilesSearchRequest(ctx context.Context, d *schema.Resourc
 		viewPoint = fabricv4.GetServiceProfilesViewPointParameter(viewPointSchema.(string))
 	}
 
-	serviceProfiles, _, err := client.ServiceProfilesApi.SearchServiceProfiles(ctx).ViewPoint(viewPoint).ServiceProfileSearchRequest(serviceProfilesSearchRequest).Execute()
+	serviceProfiles, httpResp, err := client.ServiceProfilesApi.SearchServiceProfiles(ctx).ViewPoint(viewPoint).ServiceProfileSearchRequest(serviceProfilesSearchRequest).Execute()
 	if err != nil {
-		if !strings.Contains(err.Error(), "500") {
+		if equinix_errors.IsFabricNotFound(httpResp, err) {
 			d.SetId("")
 		}
 		return diag.FromErr(equinix_errors.FormatFabricError(err))
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/equinix/terraform-provider-equinix/internal/config"
+	"github.com/equinix/terraform-provider-equinix/internal/importer"
 	equinix_validation "github.com/equinix/terraform-provider-equinix/internal/validation"
 
 	"github.com/equinix/ne-go"
@@ -65,11 +66,10 @@ func resourceNetworkACLTemplate() *schema.Resource {
 		ReadContext:   resourceNetworkACLTemplateRead,
 		UpdateContext: resourceNetworkACLTemplateUpdate,
 		DeleteContext: resourceNetworkACLTemplateDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Schema:      createNetworkACLTemplateSchema(),
-		Description: "Resource allows creation and management of Equinix Network Edge device Access Control List templates",
+		Importer:      importer.UUIDImporter(),
+		Identity:      importer.UUIDIdentity(),
+		Schema:        createNetworkACLTemplateSchema(),
+		Description:   "Resource allows creation and management of Equinix Network Edge device Access Control List templates",
 	}
 }
 
@@ -216,6 +216,9 @@ func resourceNetworkACLTemplateRead(_ context.Context, d *schema.ResourceData, m
 	if err := updateACLTemplateResource(template, d); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := importer.SetUUIDIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
 	return diags
 }
 
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/equinix/terraform-provider-equinix/internal/config"
+	"github.com/equinix/terraform-provider-equinix/internal/importer"
 
 	"github.com/equinix/ne-go"
 	"github.com/equinix/rest-go"
@@ -48,10 +49,9 @@ func resourceNetworkBGP() *schema.Resource {
 		ReadContext:   resourceNetworkBGPRead,
 		UpdateContext: resourceNetworkBGPUpdate,
 		DeleteContext: resourceNetworkBGPDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
-		Schema: createNetworkBGPResourceSchema(),
+		Importer:      importer.UUIDImporter(),
+		Identity:      importer.UUIDIdentity(),
+		Schema:        createNetworkBGPResourceSchema(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 		},
@@ -163,6 +163,9 @@ func resourceNetworkBGPRead(ctx context.Context, d *schema.ResourceData, m any)
 	if err := updateNetworkBGPResource(bgp, d); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := importer.SetUUIDIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
 	return diags
 }
 
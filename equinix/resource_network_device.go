@@ -15,6 +15,8 @@ import (
 	"github.com/equinix/terraform-provider-equinix/internal/comparisons"
 	"github.com/equinix/terraform-provider-equinix/internal/config"
 	"github.com/equinix/terraform-provider-equinix/internal/converters"
+	"github.com/equinix/terraform-provider-equinix/internal/importer"
+	"github.com/equinix/terraform-provider-equinix/internal/network"
 	equinix_schema "github.com/equinix/terraform-provider-equinix/internal/schema"
 	equinix_validation "github.com/equinix/terraform-provider-equinix/internal/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -90,7 +92,7 @@ var neDeviceDescriptions = map[string]string{
 	"ThroughputUnit":            "Device license throughput unit (Mbps or Gbps)",
 	"HostName":                  "Device hostname prefix",
 	"PackageCode":               "Device software package code",
-	"Version":                   "Device software software version",
+	"Version":                   "Device software software version. Changing the version performs an in-place software upgrade of the primary and secondary devices",
 	"IsBYOL":                    "Boolean value that determines device licensing mode: bring your own license or subscription (default)",
 	"LicenseToken":              "License Token applicable for some device types in BYOL licensing mode",
 	"LicenseFile":               "Path to the license file that will be uploaded and applied on a device, applicable for some device types in BYOL licensing mode",
@@ -244,11 +246,13 @@ func resourceNetworkDevice() *schema.Resource {
 		ReadContext:   resourceNetworkDeviceRead,
 		UpdateContext: resourceNetworkDeviceUpdate,
 		DeleteContext: resourceNetworkDeviceDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Schema: createNetworkDeviceSchema(),
+		Importer:      importer.UUIDImporter(),
+		Identity:      importer.UUIDIdentity(),
+		Schema:        createNetworkDeviceSchema(),
 		CustomizeDiff: customdiff.All(
+			customdiff.IfValueChange("version", func(_ context.Context, old, _, _ any) bool {
+				return old != nil && old != ""
+			}, validateNetworkDeviceSoftwareUpgrade),
 			customdiff.ForceNewIfChange("throughput", func(_ context.Context, old, newValue, _ any) bool {
 				return old != nil && old != "" && old != newValue
 			}),
@@ -388,7 +392,6 @@ func createNetworkDeviceSchema() map[string]*schema.Schema {
 		neDeviceSchemaNames["Version"]: {
 			Type:         schema.TypeString,
 			Required:     true,
-			ForceNew:     true,
 			ValidateFunc: validation.StringIsNotEmpty,
 			Description:  neDeviceDescriptions["Version"],
 		},
@@ -1161,6 +1164,9 @@ func resourceNetworkDeviceRead(_ context.Context, d *schema.ResourceData, m any)
 	if err = updateNetworkDeviceResource(primary, secondary, d); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := importer.SetUUIDIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
 	return diags
 }
 
@@ -1235,6 +1241,11 @@ func resourceNetworkDeviceUpdate(ctx context.Context, d *schema.ResourceData, m
 			return diag.Errorf("error waiting for network device %q to be updated: %s", d.Get(neDeviceSchemaNames["RedundantUUID"]), err)
 		}
 	}
+	if d.HasChange(neDeviceSchemaNames["Version"]) {
+		if err := upgradeNetworkDeviceSoftware(ctx, client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	var isSecondaryAdded = equinix_schema.IsDataElementAdded(neDeviceSchemaNames["Secondary"], d)
 	if isSecondaryAdded {
 		var secondary *ne.Device
@@ -1297,6 +1308,88 @@ func resourceNetworkDeviceDelete(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+// validateNetworkDeviceSoftwareUpgrade ensures that the requested version is an
+// available software version for the device type and package before an in-place
+// upgrade is planned
+func validateNetworkDeviceSoftwareUpgrade(_ context.Context, d *schema.ResourceDiff, m any) error {
+	typeCode := d.Get(neDeviceSchemaNames["TypeCode"]).(string)
+	packageCode := d.Get(neDeviceSchemaNames["PackageCode"]).(string)
+	version := d.Get(neDeviceSchemaNames["Version"]).(string)
+	if typeCode == "" || version == "" {
+		return nil
+	}
+	versions, err := m.(*config.Config).Ne.GetDeviceSoftwareVersions(typeCode)
+	if err != nil {
+		return fmt.Errorf("cannot validate software version %q for device type %q: %w", version, typeCode, err)
+	}
+	return validateNetworkDeviceSoftwareVersion(versions, typeCode, packageCode, version)
+}
+
+func validateNetworkDeviceSoftwareVersion(versions []ne.DeviceSoftwareVersion, typeCode, packageCode, version string) error {
+	for _, v := range versions {
+		if ne.StringValue(v.Version) != version {
+			continue
+		}
+		if packageCode != "" && len(v.PackageCodes) > 0 && !slices.Contains(v.PackageCodes, packageCode) {
+			return fmt.Errorf("software version %q of device type %q does not support package %q, supported packages: %v", version, typeCode, packageCode, v.PackageCodes)
+		}
+		return nil
+	}
+	return fmt.Errorf("software version %q is not available for device type %q, see the equinix_network_device_software data source for available versions", version, typeCode)
+}
+
+// upgradeNetworkDeviceSoftware upgrades the secondary device, if present, and
+// then the primary device, one at a time. The primary device is upgraded last
+// because its version is the one kept in state: when an upgrade fails part way
+// the plan still shows the version change and a retry skips devices that
+// already run the target version.
+func upgradeNetworkDeviceSoftware(ctx context.Context, client ne.Client, d *schema.ResourceData) error {
+	oldVersion, newVersion := d.GetChange(neDeviceSchemaNames["Version"])
+	version := newVersion.(string)
+	neClient, err := network.NewClient(client)
+	if err != nil {
+		return err
+	}
+	var deviceIDs []string
+	if v, ok := d.GetOk(neDeviceSchemaNames["RedundantUUID"]); ok {
+		deviceIDs = append(deviceIDs, v.(string))
+	}
+	deviceIDs = append(deviceIDs, d.Id())
+	var upgraded []string
+	for _, deviceID := range deviceIDs {
+		device, err := client.GetDevice(deviceID)
+		if err != nil {
+			err = fmt.Errorf("cannot fetch network device %q: %w", deviceID, err)
+			return rollbackNetworkDeviceVersion(d, oldVersion.(string), upgraded, err)
+		}
+		if ne.StringValue(device.Version) != version {
+			if err := neClient.UpgradeDeviceSoftware(deviceID, version); err != nil {
+				err = fmt.Errorf("error requesting software upgrade of network device %q to version %q: %w", deviceID, version, err)
+				return rollbackNetworkDeviceVersion(d, oldVersion.(string), upgraded, err)
+			}
+			waitConfig := createNetworkDeviceSoftwareUpgradeWaitConfiguration(client.GetDevice, deviceID, version, 5*time.Second, d.Timeout(schema.TimeoutUpdate))
+			if _, err := waitConfig.WaitForStateContext(ctx); err != nil {
+				err = fmt.Errorf("error waiting for software upgrade of network device %q to version %q: %w", deviceID, version, err)
+				return rollbackNetworkDeviceVersion(d, oldVersion.(string), upgraded, err)
+			}
+		}
+		upgraded = append(upgraded, deviceID)
+	}
+	return nil
+}
+
+// rollbackNetworkDeviceVersion keeps the previous version in state so that the
+// failed upgrade is planned again, and reports which devices were upgraded
+func rollbackNetworkDeviceVersion(d *schema.ResourceData, oldVersion string, upgraded []string, err error) error {
+	if len(upgraded) > 0 {
+		err = fmt.Errorf("%w; devices %v already run the new version, apply again to upgrade the remaining devices", err, upgraded)
+	}
+	if setErr := d.Set(neDeviceSchemaNames["Version"], oldVersion); setErr != nil {
+		return errors.Join(err, fmt.Errorf("error restoring Version: %w", setErr))
+	}
+	return err
+}
+
 func createNetworkDevices(d *schema.ResourceData) (*ne.Device, *ne.Device) {
 	var primary, secondary *ne.Device
 	primary = &ne.Device{}
@@ -2003,6 +2096,37 @@ func createNetworkDeviceStatusResourceUpgradeWaitConfiguration(fetchFunc getDevi
 	return createNetworkDeviceStatusWaitConfiguration(fetchFunc, id, delay, timeout, target, pending)
 }
 
+func createNetworkDeviceSoftwareUpgradeWaitConfiguration(fetchFunc getDevice, id string, version string, delay time.Duration, timeout time.Duration) *retry.StateChangeConf {
+	return &retry.StateChangeConf{
+		Pending: []string{
+			network.DeviceStateSoftwareUpgradeInProgress,
+			ne.DeviceStateResourceUpgradeInProgress,
+			ne.DeviceStateWaitingPrimary,
+			ne.DeviceStateWaitingSecondary,
+			ne.DeviceStateWaitingClusterNodes,
+		},
+		Target: []string{
+			ne.DeviceStateProvisioned,
+		},
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: delay,
+		Refresh: func() (any, string, error) {
+			resp, err := fetchFunc(id)
+			if err != nil {
+				return nil, "", err
+			}
+			status := ne.StringValue(resp.Status)
+			// The device may still report as provisioned on the old version
+			// before the upgrade is picked up
+			if status == ne.DeviceStateProvisioned && ne.StringValue(resp.Version) != version {
+				status = network.DeviceStateSoftwareUpgradeInProgress
+			}
+			return resp, status, nil
+		},
+	}
+}
+
 func createNetworkDeviceStatusWaitConfiguration(fetchFunc getDevice, id string, delay time.Duration, timeout time.Duration, target []string, pending []string) *retry.StateChangeConf {
 	return &retry.StateChangeConf{
 		Pending:    pending,
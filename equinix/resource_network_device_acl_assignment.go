@@ -0,0 +1,265 @@
+package equinix
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/equinix/terraform-provider-equinix/internal/config"
+	"github.com/equinix/terraform-provider-equinix/internal/importer"
+
+	"github.com/equinix/ne-go"
+	"github.com/equinix/rest-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	networkDeviceACLTypeWAN  = "WAN"
+	networkDeviceACLTypeMgmt = "MGMT"
+)
+
+var networkDeviceACLTypes = []string{
+	networkDeviceACLTypeWAN,
+	networkDeviceACLTypeMgmt,
+}
+
+var networkDeviceACLAssignmentTargets = []string{
+	networkDeviceActionTargetPrimary,
+	networkDeviceActionTargetSecondary,
+	networkDeviceActionTargetClusterNode0,
+	networkDeviceActionTargetClusterNode1,
+}
+
+var networkDeviceACLAssignmentSchemaNames = map[string]string{
+	"DeviceID":        "device_id",
+	"Target":          "target",
+	"Type":            "type",
+	"ACLTemplateID":   "acl_template_id",
+	"TargetDeviceID":  "target_device_id",
+	"DeviceACLStatus": "device_acl_status",
+}
+
+var networkDeviceACLAssignmentDescriptions = map[string]string{
+	"DeviceID":        "Unique identifier of the primary device or cluster the ACL template is assigned to",
+	"Target":          "Which device the ACL template is assigned to: PRIMARY (default), SECONDARY, CLUSTER_NODE_0 or CLUSTER_NODE_1",
+	"Type":            fmt.Sprintf("Type of the ACL template assignment, WAN (default) for the WAN interface or MGMT for the management interface. One of %v", networkDeviceACLTypes),
+	"ACLTemplateID":   "Unique identifier of the ACL template assigned to the device",
+	"TargetDeviceID":  "Unique identifier of the device the ACL template is assigned to",
+	"DeviceACLStatus": "Provisioning status of the ACL template on the device",
+}
+
+// networkDeviceACLAssignmentIdentityAttrs are the attributes composing the ID
+// of an ACL template assignment, in the form of device_id/target/type
+var networkDeviceACLAssignmentIdentityAttrs = []string{
+	networkDeviceACLAssignmentSchemaNames["DeviceID"],
+	networkDeviceACLAssignmentSchemaNames["Target"],
+	networkDeviceACLAssignmentSchemaNames["Type"],
+}
+
+func resourceNetworkDeviceACLAssignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkDeviceACLAssignmentCreate,
+		ReadContext:   resourceNetworkDeviceACLAssignmentRead,
+		UpdateContext: resourceNetworkDeviceACLAssignmentUpdate,
+		DeleteContext: resourceNetworkDeviceACLAssignmentDelete,
+		Importer:      importer.CompositeImporter(networkDeviceACLAssignmentIdentityAttrs...),
+		Identity: importer.CompositeIdentity(map[string]string{
+			networkDeviceACLAssignmentSchemaNames["DeviceID"]: networkDeviceACLAssignmentDescriptions["DeviceID"],
+			networkDeviceACLAssignmentSchemaNames["Target"]:   networkDeviceACLAssignmentDescriptions["Target"],
+			networkDeviceACLAssignmentSchemaNames["Type"]:     networkDeviceACLAssignmentDescriptions["Type"],
+		}),
+		Schema: createNetworkDeviceACLAssignmentResourceSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Description: "Resource allows assigning an ACL template to the WAN or management interface of an Equinix Network Edge device",
+	}
+}
+
+func createNetworkDeviceACLAssignmentResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		networkDeviceACLAssignmentSchemaNames["DeviceID"]: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  networkDeviceACLAssignmentDescriptions["DeviceID"],
+		},
+		networkDeviceACLAssignmentSchemaNames["Target"]: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      networkDeviceActionTargetPrimary,
+			ValidateFunc: validation.StringInSlice(networkDeviceACLAssignmentTargets, false),
+			Description:  networkDeviceACLAssignmentDescriptions["Target"],
+		},
+		networkDeviceACLAssignmentSchemaNames["Type"]: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      networkDeviceACLTypeWAN,
+			ValidateFunc: validation.StringInSlice(networkDeviceACLTypes, false),
+			Description:  networkDeviceACLAssignmentDescriptions["Type"],
+		},
+		networkDeviceACLAssignmentSchemaNames["ACLTemplateID"]: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  networkDeviceACLAssignmentDescriptions["ACLTemplateID"],
+		},
+		networkDeviceACLAssignmentSchemaNames["TargetDeviceID"]: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: networkDeviceACLAssignmentDescriptions["TargetDeviceID"],
+		},
+		networkDeviceACLAssignmentSchemaNames["DeviceACLStatus"]: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: networkDeviceACLAssignmentDescriptions["DeviceACLStatus"],
+		},
+	}
+}
+
+func resourceNetworkDeviceACLAssignmentCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*config.Config).Ne
+	m.(*config.Config).AddModuleToNEUserAgent(&client, d)
+	deviceID := d.Get(networkDeviceACLAssignmentSchemaNames["DeviceID"]).(string)
+	device, err := client.GetDevice(deviceID)
+	if err != nil {
+		return diag.Errorf("cannot fetch network device %q due to %v", deviceID, err)
+	}
+	targetIDs, err := getNetworkDeviceActionTargetIDs(device, d.Get(networkDeviceACLAssignmentSchemaNames["Target"]).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	targetID := targetIDs[0]
+	aclType := d.Get(networkDeviceACLAssignmentSchemaNames["Type"]).(string)
+	templateID := d.Get(networkDeviceACLAssignmentSchemaNames["ACLTemplateID"]).(string)
+	if err := assignNetworkDeviceACLTemplate(ctx, client, targetID, aclType, templateID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(importer.CompositeID(deviceID, d.Get(networkDeviceACLAssignmentSchemaNames["Target"]).(string), aclType))
+	if err := d.Set(networkDeviceACLAssignmentSchemaNames["TargetDeviceID"], targetID); err != nil {
+		return diag.Errorf("error reading TargetDeviceID: %s", err)
+	}
+	return resourceNetworkDeviceACLAssignmentRead(ctx, d, m)
+}
+
+func resourceNetworkDeviceACLAssignmentRead(_ context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*config.Config).Ne
+	m.(*config.Config).AddModuleToNEUserAgent(&client, d)
+	var diags diag.Diagnostics
+	// Imported assignments only know the device and target, the target
+	// device is resolved from them
+	if d.Get(networkDeviceACLAssignmentSchemaNames["TargetDeviceID"]).(string) == "" {
+		deviceID := d.Get(networkDeviceACLAssignmentSchemaNames["DeviceID"]).(string)
+		device, err := client.GetDevice(deviceID)
+		if err != nil {
+			return diag.Errorf("cannot fetch network device %q due to %v", deviceID, err)
+		}
+		targetIDs, err := getNetworkDeviceActionTargetIDs(device, d.Get(networkDeviceACLAssignmentSchemaNames["Target"]).(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set(networkDeviceACLAssignmentSchemaNames["TargetDeviceID"], targetIDs[0]); err != nil {
+			return diag.Errorf("error reading TargetDeviceID: %s", err)
+		}
+	}
+	targetID := d.Get(networkDeviceACLAssignmentSchemaNames["TargetDeviceID"]).(string)
+	device, err := client.GetDevice(targetID)
+	if err != nil {
+		if restErr, ok := err.(rest.Error); ok && restErr.HTTPCode == http.StatusNotFound {
+			log.Printf("[WARN] network device %q not found, removing ACL assignment %q from state", targetID, d.Id())
+			d.SetId("")
+			return diags
+		}
+		return diag.Errorf("cannot fetch network device %q due to %v", targetID, err)
+	}
+	if slices.Contains([]string{ne.DeviceStateDeprovisioning, ne.DeviceStateDeprovisioned}, ne.StringValue(device.Status)) {
+		d.SetId("")
+		return diags
+	}
+	// The template assigned to the device is read back so that templates
+	// swapped outside of Terraform show up as drift
+	templateID := ne.StringValue(device.ACLTemplateUUID)
+	if d.Get(networkDeviceACLAssignmentSchemaNames["Type"]).(string) == networkDeviceACLTypeMgmt {
+		templateID = ne.StringValue(device.MgmtAclTemplateUuid)
+	}
+	if templateID == "" {
+		log.Printf("[WARN] no ACL template is assigned to network device %q, removing ACL assignment %q from state", targetID, d.Id())
+		d.SetId("")
+		return diags
+	}
+	if err := d.Set(networkDeviceACLAssignmentSchemaNames["ACLTemplateID"], templateID); err != nil {
+		return diag.Errorf("error reading ACLTemplateID: %s", err)
+	}
+	aclDetails, err := client.GetDeviceACLDetails(targetID)
+	if err != nil {
+		return diag.Errorf("cannot fetch ACL details of network device %q due to %v", targetID, err)
+	}
+	if err := d.Set(networkDeviceACLAssignmentSchemaNames["DeviceACLStatus"], aclDetails.Status); err != nil {
+		return diag.Errorf("error reading DeviceACLStatus: %s", err)
+	}
+	if err := importer.SetCompositeIdentity(d, networkDeviceACLAssignmentIdentityAttrs...); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+func resourceNetworkDeviceACLAssignmentUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*config.Config).Ne
+	m.(*config.Config).AddModuleToNEUserAgent(&client, d)
+	if d.HasChange(networkDeviceACLAssignmentSchemaNames["ACLTemplateID"]) {
+		targetID := d.Get(networkDeviceACLAssignmentSchemaNames["TargetDeviceID"]).(string)
+		aclType := d.Get(networkDeviceACLAssignmentSchemaNames["Type"]).(string)
+		templateID := d.Get(networkDeviceACLAssignmentSchemaNames["ACLTemplateID"]).(string)
+		if err := assignNetworkDeviceACLTemplate(ctx, client, targetID, aclType, templateID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceNetworkDeviceACLAssignmentRead(ctx, d, m)
+}
+
+func resourceNetworkDeviceACLAssignmentDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*config.Config).Ne
+	m.(*config.Config).AddModuleToNEUserAgent(&client, d)
+	var diags diag.Diagnostics
+	targetID := d.Get(networkDeviceACLAssignmentSchemaNames["TargetDeviceID"]).(string)
+	aclType := d.Get(networkDeviceACLAssignmentSchemaNames["Type"]).(string)
+	if err := assignNetworkDeviceACLTemplate(ctx, client, targetID, aclType, "", d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+// assignNetworkDeviceACLTemplate assigns the template to the WAN or management
+// interface of the device and waits until it is provisioned. An empty template
+// ID removes the assigned template
+func assignNetworkDeviceACLTemplate(ctx context.Context, client ne.Client, deviceID, aclType, templateID string, timeout time.Duration) error {
+	updateReq := createNetworkDeviceACLAssignmentUpdateRequest(client.NewDeviceUpdateRequest, deviceID, aclType, templateID)
+	if err := updateReq.Execute(); err != nil {
+		return fmt.Errorf("error assigning %s ACL template %q to network device %q: %s", aclType, templateID, deviceID, err)
+	}
+	if templateID == "" {
+		return nil
+	}
+	if _, err := createNetworkDeviceACLStatusWaitConfiguration(client.GetDeviceACLDetails, deviceID, 1*time.Second, timeout).WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for %s ACL template %q to be provisioned on network device %q: %s", aclType, templateID, deviceID, err)
+	}
+	return nil
+}
+
+func createNetworkDeviceACLAssignmentUpdateRequest(reqFunc func(uuid string) ne.DeviceUpdateRequest, deviceID, aclType, templateID string) ne.DeviceUpdateRequest {
+	updateReq := reqFunc(deviceID)
+	if aclType == networkDeviceACLTypeMgmt {
+		return updateReq.WithMgmtAclTemplate(templateID)
+	}
+	return updateReq.WithACLTemplate(templateID)
+}
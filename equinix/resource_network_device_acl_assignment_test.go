@@ -0,0 +1,52 @@
+package equinix
+
+import (
+	"testing"
+
+	"github.com/equinix/ne-go"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockedDeviceACLUpdateRequest struct {
+	ne.DeviceUpdateRequest
+	uuid string
+	data map[string]string
+}
+
+func (r *mockedDeviceACLUpdateRequest) WithACLTemplate(templateID string) ne.DeviceUpdateRequest {
+	r.data["aclTemplateUuid"] = templateID
+	return r
+}
+
+func (r *mockedDeviceACLUpdateRequest) WithMgmtAclTemplate(templateID string) ne.DeviceUpdateRequest {
+	r.data["mgmtAclTemplateUuid"] = templateID
+	return r
+}
+
+func TestNetworkDeviceACLAssignment_createUpdateRequest(t *testing.T) {
+	tests := []struct {
+		name       string
+		aclType    string
+		templateID string
+		expected   map[string]string
+	}{
+		{"wan template", networkDeviceACLTypeWAN, "wan-template", map[string]string{"aclTemplateUuid": "wan-template"}},
+		{"mgmt template", networkDeviceACLTypeMgmt, "mgmt-template", map[string]string{"mgmtAclTemplateUuid": "mgmt-template"}},
+		{"wan template removal", networkDeviceACLTypeWAN, "", map[string]string{"aclTemplateUuid": ""}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			req := &mockedDeviceACLUpdateRequest{data: make(map[string]string)}
+			f := func(uuid string) ne.DeviceUpdateRequest {
+				req.uuid = uuid
+				return req
+			}
+			// when
+			createNetworkDeviceACLAssignmentUpdateRequest(f, "node0", tc.aclType, tc.templateID)
+			// then
+			assert.Equal(t, "node0", req.uuid, "Request is created for the target device")
+			assert.Equal(t, tc.expected, req.data, "Only the template of the given type is changed")
+		})
+	}
+}
@@ -0,0 +1,330 @@
+package equinix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/equinix/terraform-provider-equinix/internal/config"
+	"github.com/equinix/terraform-provider-equinix/internal/network"
+
+	"github.com/equinix/ne-go"
+	"github.com/equinix/rest-go"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	networkDeviceActionTargetPrimary      = "PRIMARY"
+	networkDeviceActionTargetSecondary    = "SECONDARY"
+	networkDeviceActionTargetClusterNode0 = "CLUSTER_NODE_0"
+	networkDeviceActionTargetClusterNode1 = "CLUSTER_NODE_1"
+	networkDeviceActionTargetAll          = "ALL"
+)
+
+var networkDeviceActionTargets = []string{
+	networkDeviceActionTargetPrimary,
+	networkDeviceActionTargetSecondary,
+	networkDeviceActionTargetClusterNode0,
+	networkDeviceActionTargetClusterNode1,
+	networkDeviceActionTargetAll,
+}
+
+var networkDeviceActionSchemaNames = map[string]string{
+	"DeviceID":        "device_id",
+	"Action":          "action",
+	"Target":          "target",
+	"BackupID":        "backup_id",
+	"Triggers":        "triggers",
+	"TargetDeviceIDs": "target_device_ids",
+	"CompletedAt":     "completed_at",
+}
+
+var networkDeviceActionDescriptions = map[string]string{
+	"DeviceID":        "Unique identifier of the primary device or cluster the action is performed on",
+	"Action":          fmt.Sprintf("Lifecycle action to perform. One of %v", network.DeviceActions),
+	"Target":          "Which device the action is performed on: PRIMARY (default), SECONDARY, CLUSTER_NODE_0, CLUSTER_NODE_1 or ALL. ALL performs the action on every cluster node, or on the secondary and then the primary device, one at a time",
+	"BackupID":        "Unique identifier of the device backup to restore. Required when action is RESTORE. The backup is restored on the device it was taken from only, so target has to select that device and cannot be ALL",
+	"Triggers":        "Arbitrary map of values that, when changed, will run the action again",
+	"TargetDeviceIDs": "Unique identifiers of the devices the action was performed on, in order",
+	"CompletedAt":     "Time when the action completed and all target devices returned to PROVISIONED state",
+}
+
+func resourceNetworkDeviceAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkDeviceActionCreate,
+		ReadContext:   resourceNetworkDeviceActionRead,
+		DeleteContext: resourceNetworkDeviceActionDelete,
+		Schema:        createNetworkDeviceActionResourceSchema(),
+		CustomizeDiff: validateNetworkDeviceActionBackup,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Description: "Resource allows performing lifecycle actions, such as reboots and backup restores, on Equinix Network Edge devices",
+	}
+}
+
+func createNetworkDeviceActionResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		networkDeviceActionSchemaNames["DeviceID"]: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  networkDeviceActionDescriptions["DeviceID"],
+		},
+		networkDeviceActionSchemaNames["Action"]: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(network.DeviceActions, false),
+			Description:  networkDeviceActionDescriptions["Action"],
+		},
+		networkDeviceActionSchemaNames["Target"]: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      networkDeviceActionTargetPrimary,
+			ValidateFunc: validation.StringInSlice(networkDeviceActionTargets, false),
+			Description:  networkDeviceActionDescriptions["Target"],
+		},
+		networkDeviceActionSchemaNames["BackupID"]: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  networkDeviceActionDescriptions["BackupID"],
+		},
+		networkDeviceActionSchemaNames["Triggers"]: {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			ForceNew:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: networkDeviceActionDescriptions["Triggers"],
+		},
+		networkDeviceActionSchemaNames["TargetDeviceIDs"]: {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: networkDeviceActionDescriptions["TargetDeviceIDs"],
+		},
+		networkDeviceActionSchemaNames["CompletedAt"]: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: networkDeviceActionDescriptions["CompletedAt"],
+		},
+	}
+}
+
+func resourceNetworkDeviceActionCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*config.Config).Ne
+	m.(*config.Config).AddModuleToNEUserAgent(&client, d)
+	deviceID := d.Get(networkDeviceActionSchemaNames["DeviceID"]).(string)
+	action := d.Get(networkDeviceActionSchemaNames["Action"]).(string)
+	device, err := client.GetDevice(deviceID)
+	if err != nil {
+		return diag.Errorf("cannot fetch network device %q due to %v", deviceID, err)
+	}
+	targetIDs, err := getNetworkDeviceActionTargetIDs(device, d.Get(networkDeviceActionSchemaNames["Target"]).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	neClient, err := network.NewClient(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// Devices are handled one at a time so that a redundant pair or cluster
+	// keeps serving traffic while the action runs
+	backupID := d.Get(networkDeviceActionSchemaNames["BackupID"]).(string)
+	if action == network.DeviceActionRestore {
+		backup, err := neClient.GetDeviceBackup(backupID)
+		if err != nil {
+			return diag.Errorf("cannot fetch network device backup %q due to %v", backupID, err)
+		}
+		if err := validateNetworkDeviceActionRestoreTargets(backup, targetIDs); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	for _, targetID := range targetIDs {
+		if action == network.DeviceActionRestore {
+			err = neClient.RestoreDeviceBackup(targetID, backupID)
+		} else {
+			err = neClient.TriggerDeviceAction(targetID, action)
+		}
+		if err != nil {
+			return diag.Errorf("error performing %s on network device %q: %s", action, targetID, err)
+		}
+		if err := waitForNetworkDeviceAction(ctx, client.GetDevice, targetID, 5*time.Second, networkDeviceActionStartTimeout, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error waiting for network device %q to complete %s: %s", targetID, action, err)
+		}
+	}
+	d.SetId(id)
+	if err := d.Set(networkDeviceActionSchemaNames["TargetDeviceIDs"], targetIDs); err != nil {
+		return diag.Errorf("error reading TargetDeviceIDs: %s", err)
+	}
+	if err := d.Set(networkDeviceActionSchemaNames["CompletedAt"], time.Now().UTC().Format(time.RFC3339)); err != nil {
+		return diag.Errorf("error reading CompletedAt: %s", err)
+	}
+	return resourceNetworkDeviceActionRead(ctx, d, m)
+}
+
+func resourceNetworkDeviceActionRead(_ context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*config.Config).Ne
+	m.(*config.Config).AddModuleToNEUserAgent(&client, d)
+	var diags diag.Diagnostics
+	deviceID := d.Get(networkDeviceActionSchemaNames["DeviceID"]).(string)
+	device, err := client.GetDevice(deviceID)
+	if err != nil {
+		if restErr, ok := err.(rest.Error); ok && restErr.HTTPCode == http.StatusNotFound {
+			log.Printf("[WARN] network device %q not found, removing device action %q from state", deviceID, d.Id())
+			d.SetId("")
+			return diags
+		}
+		return diag.Errorf("cannot fetch network device %q due to %v", deviceID, err)
+	}
+	if slices.Contains([]string{ne.DeviceStateDeprovisioning, ne.DeviceStateDeprovisioned}, ne.StringValue(device.Status)) {
+		d.SetId("")
+	}
+	return diags
+}
+
+// resourceNetworkDeviceActionDelete only removes the action from state, as an
+// action that was performed cannot be undone
+func resourceNetworkDeviceActionDelete(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func validateNetworkDeviceActionBackup(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown(networkDeviceActionSchemaNames["BackupID"]) {
+		return nil
+	}
+	action := d.Get(networkDeviceActionSchemaNames["Action"]).(string)
+	backupID := d.Get(networkDeviceActionSchemaNames["BackupID"]).(string)
+	if action == network.DeviceActionRestore && backupID == "" {
+		return fmt.Errorf("%s is required when action is %s", networkDeviceActionSchemaNames["BackupID"], network.DeviceActionRestore)
+	}
+	if action != network.DeviceActionRestore && backupID != "" {
+		return fmt.Errorf("%s can only be set when action is %s", networkDeviceActionSchemaNames["BackupID"], network.DeviceActionRestore)
+	}
+	target := d.Get(networkDeviceActionSchemaNames["Target"]).(string)
+	if action == network.DeviceActionRestore && target == networkDeviceActionTargetAll {
+		return fmt.Errorf("%s cannot be %s when action is %s, as a backup belongs to a single device", networkDeviceActionSchemaNames["Target"], networkDeviceActionTargetAll, network.DeviceActionRestore)
+	}
+	return nil
+}
+
+// validateNetworkDeviceActionRestoreTargets ensures the backup is restored on
+// the device it was taken from only
+func validateNetworkDeviceActionRestoreTargets(backup *network.DeviceBackup, targetIDs []string) error {
+	backupDeviceID := ne.StringValue(backup.DeviceUUID)
+	for _, targetID := range targetIDs {
+		if targetID != backupDeviceID {
+			return fmt.Errorf("network device backup %q belongs to device %q and cannot be restored on device %q", ne.StringValue(backup.UUID), backupDeviceID, targetID)
+		}
+	}
+	return nil
+}
+
+func getNetworkDeviceActionTargetIDs(device *ne.Device, target string) ([]string, error) {
+	primaryID := ne.StringValue(device.UUID)
+	secondaryID := ne.StringValue(device.RedundantUUID)
+	var node0ID, node1ID string
+	if device.ClusterDetails != nil {
+		if device.ClusterDetails.Node0 != nil {
+			node0ID = ne.StringValue(device.ClusterDetails.Node0.UUID)
+		}
+		if device.ClusterDetails.Node1 != nil {
+			node1ID = ne.StringValue(device.ClusterDetails.Node1.UUID)
+		}
+	}
+	switch target {
+	case networkDeviceActionTargetPrimary:
+		return []string{primaryID}, nil
+	case networkDeviceActionTargetSecondary:
+		if secondaryID == "" {
+			return nil, fmt.Errorf("network device %q does not have a secondary device", primaryID)
+		}
+		return []string{secondaryID}, nil
+	case networkDeviceActionTargetClusterNode0, networkDeviceActionTargetClusterNode1:
+		nodeID := node0ID
+		if target == networkDeviceActionTargetClusterNode1 {
+			nodeID = node1ID
+		}
+		if nodeID == "" {
+			return nil, fmt.Errorf("network device %q does not have cluster node details for %s", primaryID, target)
+		}
+		return []string{nodeID}, nil
+	case networkDeviceActionTargetAll:
+		if node0ID != "" && node1ID != "" {
+			return []string{node0ID, node1ID}, nil
+		}
+		if secondaryID != "" {
+			return []string{secondaryID, primaryID}, nil
+		}
+		return []string{primaryID}, nil
+	}
+	return nil, fmt.Errorf("unsupported network device action target %q", target)
+}
+
+// networkDeviceActionInProgressStates lists the states of a device while an
+// action is performed on it
+var networkDeviceActionInProgressStates = []string{
+	network.DeviceStateRebooting,
+	network.DeviceStateRestoreInProgress,
+	ne.DeviceStateInitializing,
+	ne.DeviceStateProvisioning,
+	ne.DeviceStateWaitingPrimary,
+	ne.DeviceStateWaitingSecondary,
+	ne.DeviceStateWaitingClusterNodes,
+}
+
+// networkDeviceActionStartTimeout bounds the wait for the device to leave
+// PROVISIONED state once the action was accepted
+const networkDeviceActionStartTimeout = 3 * time.Minute
+
+// waitForNetworkDeviceAction waits for the device to leave PROVISIONED state,
+// which it keeps reporting for a while after the action was accepted, and
+// then for the device to return to PROVISIONED state once the action completed.
+// A device still PROVISIONED after startTimeout is considered done, the action
+// having completed between two polls
+func waitForNetworkDeviceAction(ctx context.Context, fetchFunc getDevice, id string, delay, startTimeout, timeout time.Duration) error {
+	start := time.Now()
+	startWaitConfig := createNetworkDeviceActionStartWaitConfiguration(fetchFunc, id, delay, min(startTimeout, timeout))
+	if _, err := startWaitConfig.WaitForStateContext(ctx); err != nil {
+		var timeoutErr *retry.TimeoutError
+		if errors.As(err, &timeoutErr) && timeoutErr.LastState == ne.DeviceStateProvisioned {
+			log.Printf("[WARN] Network device %s did not leave %s state within %s, considering the action done", id, ne.DeviceStateProvisioned, startTimeout)
+			return nil
+		}
+		return fmt.Errorf("action did not start: %w", err)
+	}
+	waitConfig := createNetworkDeviceActionWaitConfiguration(fetchFunc, id, delay, timeout-time.Since(start))
+	_, err := waitConfig.WaitForStateContext(ctx)
+	return err
+}
+
+func createNetworkDeviceActionStartWaitConfiguration(fetchFunc getDevice, id string, delay time.Duration, timeout time.Duration) *retry.StateChangeConf {
+	pending := []string{
+		ne.DeviceStateProvisioned,
+	}
+	return createNetworkDeviceStatusWaitConfiguration(fetchFunc, id, delay, timeout, networkDeviceActionInProgressStates, pending)
+}
+
+func createNetworkDeviceActionWaitConfiguration(fetchFunc getDevice, id string, delay time.Duration, timeout time.Duration) *retry.StateChangeConf {
+	target := []string{
+		ne.DeviceStateProvisioned,
+	}
+	return createNetworkDeviceStatusWaitConfiguration(fetchFunc, id, delay, timeout, target, networkDeviceActionInProgressStates)
+}
@@ -0,0 +1,130 @@
+package equinix
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/equinix/terraform-provider-equinix/internal/network"
+
+	"github.com/equinix/ne-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkDeviceAction_targetIDs(t *testing.T) {
+	// given
+	single := &ne.Device{UUID: ne.String("primary")}
+	redundant := &ne.Device{UUID: ne.String("primary"), RedundantUUID: ne.String("secondary")}
+	cluster := &ne.Device{
+		UUID: ne.String("cluster"),
+		ClusterDetails: &ne.ClusterDetails{
+			Node0: &ne.ClusterNodeDetail{UUID: ne.String("node0")},
+			Node1: &ne.ClusterNodeDetail{UUID: ne.String("node1")},
+		},
+	}
+	tests := []struct {
+		name     string
+		device   *ne.Device
+		target   string
+		expected []string
+		err      bool
+	}{
+		{"primary", redundant, networkDeviceActionTargetPrimary, []string{"primary"}, false},
+		{"secondary", redundant, networkDeviceActionTargetSecondary, []string{"secondary"}, false},
+		{"secondary of single device", single, networkDeviceActionTargetSecondary, nil, true},
+		{"all of single device", single, networkDeviceActionTargetAll, []string{"primary"}, false},
+		{"all of redundant device", redundant, networkDeviceActionTargetAll, []string{"secondary", "primary"}, false},
+		{"cluster node 1", cluster, networkDeviceActionTargetClusterNode1, []string{"node1"}, false},
+		{"all cluster nodes", cluster, networkDeviceActionTargetAll, []string{"node0", "node1"}, false},
+		{"cluster node of single device", single, networkDeviceActionTargetClusterNode0, nil, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			ids, err := getNetworkDeviceActionTargetIDs(tc.device, tc.target)
+			// then
+			if tc.err {
+				assert.Error(t, err, "Unsupported target returns an error")
+				return
+			}
+			assert.Nil(t, err, "Supported target does not return an error")
+			assert.Equal(t, tc.expected, ids, "Target device IDs match")
+		})
+	}
+}
+
+func TestNetworkDeviceAction_restoreTargets(t *testing.T) {
+	// given
+	backup := &network.DeviceBackup{
+		UUID:       ne.String("backup"),
+		DeviceUUID: ne.String("secondary"),
+	}
+	// when
+	matchingErr := validateNetworkDeviceActionRestoreTargets(backup, []string{"secondary"})
+	otherDeviceErr := validateNetworkDeviceActionRestoreTargets(backup, []string{"primary"})
+	allDevicesErr := validateNetworkDeviceActionRestoreTargets(backup, []string{"secondary", "primary"})
+	// then
+	assert.Nil(t, matchingErr, "Backup is restored on the device it belongs to")
+	assert.ErrorContains(t, otherDeviceErr, `cannot be restored on device "primary"`, "Backup is not restored on another device")
+	assert.ErrorContains(t, allDevicesErr, `cannot be restored on device "primary"`, "Backup is not restored on every device")
+}
+
+func TestNetworkDeviceAction_wait(t *testing.T) {
+	// given
+	deviceID := "test"
+	statuses := []string{
+		ne.DeviceStateProvisioned,
+		network.DeviceStateRebooting,
+		network.DeviceStateRebooting,
+		ne.DeviceStateProvisioned,
+	}
+	var queriedDeviceIDs []string
+	fetchFunc := func(uuid string) (*ne.Device, error) {
+		queriedDeviceIDs = append(queriedDeviceIDs, uuid)
+		status := statuses[min(len(queriedDeviceIDs), len(statuses))-1]
+		return &ne.Device{Status: ne.String(status)}, nil
+	}
+	// when
+	err := waitForNetworkDeviceAction(context.Background(), fetchFunc, deviceID, time.Millisecond, time.Minute, time.Minute)
+	// then
+	assert.Nil(t, err, "Wait does not return an error")
+	assert.Len(t, queriedDeviceIDs, len(statuses), "Wait returns once the device is back to PROVISIONED after leaving it")
+	assert.Equal(t, deviceID, queriedDeviceIDs[0], "Queried device ID matches")
+}
+
+func TestNetworkDeviceAction_waitCompletedBetweenPolls(t *testing.T) {
+	// given
+	fetchFunc := func(uuid string) (*ne.Device, error) {
+		return &ne.Device{Status: ne.String(ne.DeviceStateProvisioned)}, nil
+	}
+	// when
+	err := waitForNetworkDeviceAction(context.Background(), fetchFunc, "test", time.Millisecond, 500*time.Millisecond, time.Minute)
+	// then
+	assert.Nil(t, err, "Device still PROVISIONED after the start timeout is considered done")
+}
+
+func TestNetworkDeviceAction_waitNotStarted(t *testing.T) {
+	// given
+	fetchFunc := func(uuid string) (*ne.Device, error) {
+		return nil, errors.New("device unavailable")
+	}
+	// when
+	err := waitForNetworkDeviceAction(context.Background(), fetchFunc, "test", time.Millisecond, 500*time.Millisecond, time.Minute)
+	// then
+	assert.ErrorContains(t, err, "action did not start", "Device that could not be fetched is not reported as done")
+}
+
+func TestNetworkDeviceAction_waitFailed(t *testing.T) {
+	// given
+	statuses := []string{network.DeviceStateRebooting, ne.DeviceStateFailed}
+	calls := 0
+	fetchFunc := func(uuid string) (*ne.Device, error) {
+		calls++
+		return &ne.Device{Status: ne.String(statuses[min(calls, len(statuses))-1])}, nil
+	}
+	// when
+	err := waitForNetworkDeviceAction(context.Background(), fetchFunc, "test", time.Millisecond, time.Minute, time.Minute)
+	// then
+	assert.Error(t, err, "Device failing the action returns an error")
+}
@@ -0,0 +1,306 @@
+package equinix
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/equinix/terraform-provider-equinix/internal/config"
+	"github.com/equinix/terraform-provider-equinix/internal/importer"
+	"github.com/equinix/terraform-provider-equinix/internal/network"
+
+	"github.com/equinix/ne-go"
+	"github.com/equinix/rest-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var networkDeviceBackupSchemaNames = map[string]string{
+	"UUID":            "uuid",
+	"Name":            "name",
+	"DeviceUUID":      "device_id",
+	"Version":         "version",
+	"Type":            "type",
+	"Status":          "status",
+	"CreatedBy":       "created_by",
+	"CreatedDate":     "created_date",
+	"LastUpdatedDate": "last_updated_date",
+	"DownloadURL":     "download_url",
+	"DeleteAllowed":   "delete_allowed",
+}
+
+var networkDeviceBackupDescriptions = map[string]string{
+	"UUID":            "Unique identifier of the backup",
+	"Name":            "Backup name",
+	"DeviceUUID":      "Unique identifier of the device the backup is taken from",
+	"Version":         "Device software version at the time of the backup",
+	"Type":            "Device type code",
+	"Status":          "Backup status",
+	"CreatedBy":       "User that created the backup",
+	"CreatedDate":     "Backup creation date",
+	"LastUpdatedDate": "Date of the last backup update",
+	"DownloadURL":     "URL to download the backed up configuration",
+	"DeleteAllowed":   "Whether the backup can be deleted",
+}
+
+func resourceNetworkDeviceBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkDeviceBackupCreate,
+		ReadContext:   resourceNetworkDeviceBackupRead,
+		UpdateContext: resourceNetworkDeviceBackupUpdate,
+		DeleteContext: resourceNetworkDeviceBackupDelete,
+		Importer:      importer.UUIDImporter(),
+		Identity:      importer.UUIDIdentity(),
+		Schema:        createNetworkDeviceBackupResourceSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Description: "Resource allows creation and management of Equinix Network Edge device configuration backups",
+	}
+}
+
+func createNetworkDeviceBackupResourceSchema() map[string]*schema.Schema {
+	s := createNetworkDeviceBackupSchema()
+	s[networkDeviceBackupSchemaNames["Name"]] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 50),
+		Description:  networkDeviceBackupDescriptions["Name"],
+	}
+	s[networkDeviceBackupSchemaNames["DeviceUUID"]] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+		Description:  networkDeviceBackupDescriptions["DeviceUUID"],
+	}
+	return s
+}
+
+// createNetworkDeviceBackupSchema returns computed backup attributes, shared by
+// the backup resource and the backups data source
+func createNetworkDeviceBackupSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		networkDeviceBackupSchemaNames["UUID"]: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: networkDeviceBackupDescriptions["UUID"],
+		},
+		networkDeviceBackupSchemaNames["Name"]: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: networkDeviceBackupDescriptions["Name"],
+		},
+		networkDeviceBackupSchemaNames["DeviceUUID"]: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: networkDeviceBackupDescriptions["DeviceUUID"],
+		},
+		networkDeviceBackupSchemaNames["Version"]: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: networkDeviceBackupDescriptions["Version"],
+		},
+		networkDeviceBackupSchemaNames["Type"]: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: networkDeviceBackupDescriptions["Type"],
+		},
+		networkDeviceBackupSchemaNames["Status"]: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: networkDeviceBackupDescriptions["Status"],
+		},
+		networkDeviceBackupSchemaNames["CreatedBy"]: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: networkDeviceBackupDescriptions["CreatedBy"],
+		},
+		networkDeviceBackupSchemaNames["CreatedDate"]: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: networkDeviceBackupDescriptions["CreatedDate"],
+		},
+		networkDeviceBackupSchemaNames["LastUpdatedDate"]: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: networkDeviceBackupDescriptions["LastUpdatedDate"],
+		},
+		networkDeviceBackupSchemaNames["DownloadURL"]: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: networkDeviceBackupDescriptions["DownloadURL"],
+		},
+		networkDeviceBackupSchemaNames["DeleteAllowed"]: {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: networkDeviceBackupDescriptions["DeleteAllowed"],
+		},
+	}
+}
+
+func resourceNetworkDeviceBackupCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*config.Config).Ne
+	m.(*config.Config).AddModuleToNEUserAgent(&client, d)
+	var diags diag.Diagnostics
+	neClient, err := network.NewClient(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	uuid, err := neClient.CreateDeviceBackup(d.Get(networkDeviceBackupSchemaNames["DeviceUUID"]).(string), d.Get(networkDeviceBackupSchemaNames["Name"]).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(ne.StringValue(uuid))
+	waitConfig := createNetworkDeviceBackupStatusWaitConfiguration(neClient.GetDeviceBackup, d.Id(), 5*time.Second, d.Timeout(schema.TimeoutCreate))
+	if _, err := waitConfig.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for network device backup (%s) to be created: %s", d.Id(), err)
+	}
+	diags = append(diags, resourceNetworkDeviceBackupRead(ctx, d, m)...)
+	return diags
+}
+
+func resourceNetworkDeviceBackupRead(_ context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*config.Config).Ne
+	m.(*config.Config).AddModuleToNEUserAgent(&client, d)
+	var diags diag.Diagnostics
+	neClient, err := network.NewClient(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	backup, err := neClient.GetDeviceBackup(d.Id())
+	if err != nil {
+		if restErr, ok := err.(rest.Error); ok && restErr.HTTPCode == http.StatusNotFound {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+	if ne.StringValue(backup.Status) == network.DeviceBackupStatusDeleted {
+		d.SetId("")
+		return diags
+	}
+	if err := updateNetworkDeviceBackupResource(backup, d); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := importer.SetUUIDIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+func resourceNetworkDeviceBackupUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*config.Config).Ne
+	m.(*config.Config).AddModuleToNEUserAgent(&client, d)
+	var diags diag.Diagnostics
+	neClient, err := network.NewClient(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange(networkDeviceBackupSchemaNames["Name"]) {
+		if err := neClient.UpdateDeviceBackupName(d.Id(), d.Get(networkDeviceBackupSchemaNames["Name"]).(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	diags = append(diags, resourceNetworkDeviceBackupRead(ctx, d, m)...)
+	return diags
+}
+
+func resourceNetworkDeviceBackupDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*config.Config).Ne
+	m.(*config.Config).AddModuleToNEUserAgent(&client, d)
+	var diags diag.Diagnostics
+	neClient, err := network.NewClient(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := neClient.DeleteDeviceBackup(d.Id()); err != nil {
+		if restErr, ok := err.(rest.Error); ok && restErr.HTTPCode == http.StatusNotFound {
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+	waitConfig := createNetworkDeviceBackupDeleteWaitConfiguration(neClient.GetDeviceBackup, d.Id(), 2*time.Second, d.Timeout(schema.TimeoutDelete))
+	if _, err := waitConfig.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for network device backup (%s) to be removed: %s", d.Id(), err)
+	}
+	return diags
+}
+
+func updateNetworkDeviceBackupResource(backup *network.DeviceBackup, d *schema.ResourceData) error {
+	for key, value := range flattenNetworkDeviceBackup(backup) {
+		if err := d.Set(key, value); err != nil {
+			return fmt.Errorf("error reading %s: %s", key, err)
+		}
+	}
+	return nil
+}
+
+func flattenNetworkDeviceBackup(backup *network.DeviceBackup) map[string]any {
+	return map[string]any{
+		networkDeviceBackupSchemaNames["UUID"]:            backup.UUID,
+		networkDeviceBackupSchemaNames["Name"]:            backup.Name,
+		networkDeviceBackupSchemaNames["DeviceUUID"]:      backup.DeviceUUID,
+		networkDeviceBackupSchemaNames["Version"]:         backup.Version,
+		networkDeviceBackupSchemaNames["Type"]:            backup.Type,
+		networkDeviceBackupSchemaNames["Status"]:          backup.Status,
+		networkDeviceBackupSchemaNames["CreatedBy"]:       backup.CreatedBy,
+		networkDeviceBackupSchemaNames["CreatedDate"]:     backup.CreatedDate,
+		networkDeviceBackupSchemaNames["LastUpdatedDate"]: backup.LastUpdatedDate,
+		networkDeviceBackupSchemaNames["DownloadURL"]:     backup.DownloadURL,
+		networkDeviceBackupSchemaNames["DeleteAllowed"]:   backup.DeleteAllowed,
+	}
+}
+
+type getDeviceBackup func(uuid string) (*network.DeviceBackup, error)
+
+func createNetworkDeviceBackupStatusWaitConfiguration(fetchFunc getDeviceBackup, id string, delay time.Duration, timeout time.Duration) *retry.StateChangeConf {
+	return &retry.StateChangeConf{
+		Pending: []string{
+			network.DeviceBackupStatusPending,
+		},
+		Target: []string{
+			network.DeviceBackupStatusCompleted,
+		},
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: delay,
+		Refresh: func() (any, string, error) {
+			resp, err := fetchFunc(id)
+			if err != nil {
+				return nil, "", err
+			}
+			return resp, ne.StringValue(resp.Status), nil
+		},
+	}
+}
+
+func createNetworkDeviceBackupDeleteWaitConfiguration(fetchFunc getDeviceBackup, id string, delay time.Duration, timeout time.Duration) *retry.StateChangeConf {
+	return &retry.StateChangeConf{
+		Pending: []string{
+			network.DeviceBackupStatusCompleted,
+			network.DeviceBackupStatusFailed,
+			network.DeviceBackupStatusPending,
+		},
+		Target: []string{
+			network.DeviceBackupStatusDeleted,
+		},
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: delay,
+		Refresh: func() (any, string, error) {
+			resp, err := fetchFunc(id)
+			if err != nil {
+				if restErr, ok := err.(rest.Error); ok && restErr.HTTPCode == http.StatusNotFound {
+					return &network.DeviceBackup{}, network.DeviceBackupStatusDeleted, nil
+				}
+				return nil, "", err
+			}
+			return resp, ne.StringValue(resp.Status), nil
+		},
+	}
+}
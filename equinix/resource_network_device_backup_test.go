@@ -0,0 +1,73 @@
+package equinix
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/equinix/terraform-provider-equinix/internal/network"
+
+	"github.com/equinix/ne-go"
+	"github.com/equinix/rest-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkDeviceBackup_flatten(t *testing.T) {
+	// given
+	backup := &network.DeviceBackup{
+		UUID:            ne.String("backup-uuid"),
+		Name:            ne.String("nightly"),
+		DeviceUUID:      ne.String("device-uuid"),
+		Version:         ne.String("17.09.01a"),
+		Type:            ne.String("C8000V"),
+		Status:          ne.String(network.DeviceBackupStatusCompleted),
+		CreatedBy:       ne.String("user"),
+		CreatedDate:     ne.String("2024-01-01T00:00:00Z"),
+		LastUpdatedDate: ne.String("2024-01-02T00:00:00Z"),
+		DownloadURL:     ne.String("https://example.com/backup"),
+		DeleteAllowed:   ne.Bool(true),
+	}
+	// when
+	flattened := flattenNetworkDeviceBackup(backup)
+	// then
+	assert.Equal(t, backup.UUID, flattened[networkDeviceBackupSchemaNames["UUID"]], "UUID matches")
+	assert.Equal(t, backup.Name, flattened[networkDeviceBackupSchemaNames["Name"]], "Name matches")
+	assert.Equal(t, backup.DeviceUUID, flattened[networkDeviceBackupSchemaNames["DeviceUUID"]], "DeviceUUID matches")
+	assert.Equal(t, backup.Status, flattened[networkDeviceBackupSchemaNames["Status"]], "Status matches")
+	assert.Equal(t, backup.DownloadURL, flattened[networkDeviceBackupSchemaNames["DownloadURL"]], "DownloadURL matches")
+	assert.Equal(t, backup.DeleteAllowed, flattened[networkDeviceBackupSchemaNames["DeleteAllowed"]], "DeleteAllowed matches")
+	assert.Len(t, flattened, len(networkDeviceBackupSchemaNames), "All backup attributes are flattened")
+}
+
+func TestNetworkDeviceBackup_statusWaitConfiguration(t *testing.T) {
+	// given
+	backupID := "test"
+	var queriedBackupID string
+	fetchFunc := func(uuid string) (*network.DeviceBackup, error) {
+		queriedBackupID = uuid
+		return &network.DeviceBackup{Status: ne.String(network.DeviceBackupStatusCompleted)}, nil
+	}
+	delay := 100 * time.Millisecond
+	timeout := 10 * time.Minute
+	// when
+	waitConfig := createNetworkDeviceBackupStatusWaitConfiguration(fetchFunc, backupID, delay, timeout)
+	_, err := waitConfig.WaitForStateContext(context.Background())
+	// then
+	assert.Nil(t, err, "WaitForState does not return an error")
+	assert.Equal(t, backupID, queriedBackupID, "Queried backup ID matches")
+	assert.Equal(t, timeout, waitConfig.Timeout, "Backup status wait configuration timeout matches")
+	assert.Equal(t, delay, waitConfig.MinTimeout, "Backup status wait configuration min timeout matches")
+}
+
+func TestNetworkDeviceBackup_deleteWaitConfiguration(t *testing.T) {
+	// given
+	fetchFunc := func(_ string) (*network.DeviceBackup, error) {
+		return nil, rest.Error{HTTPCode: http.StatusNotFound}
+	}
+	// when
+	waitConfig := createNetworkDeviceBackupDeleteWaitConfiguration(fetchFunc, "test", 100*time.Millisecond, time.Minute)
+	_, err := waitConfig.WaitForStateContext(context.Background())
+	// then
+	assert.Nil(t, err, "Backup that is not found is treated as deleted")
+}
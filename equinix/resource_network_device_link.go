@@ -9,6 +9,7 @@ import (
 	"github.com/equinix/terraform-provider-equinix/internal/config"
 	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
 	"github.com/equinix/terraform-provider-equinix/internal/hashcode"
+	"github.com/equinix/terraform-provider-equinix/internal/importer"
 	equinix_schema "github.com/equinix/terraform-provider-equinix/internal/schema"
 	equinix_validation "github.com/equinix/terraform-provider-equinix/internal/validation"
 
@@ -79,10 +80,9 @@ func resourceNetworkDeviceLink() *schema.Resource {
 		ReadContext:   resourceNetworkDeviceLinkRead,
 		UpdateContext: resourceNetworkDeviceLinkUpdate,
 		DeleteContext: resourceNetworkDeviceLinkDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Schema: createNetworkDeviceLinkResourceSchema(),
+		Importer:      importer.UUIDImporter(),
+		Identity:      importer.UUIDIdentity(),
+		Schema:        createNetworkDeviceLinkResourceSchema(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
@@ -260,6 +260,9 @@ func resourceNetworkDeviceLinkRead(_ context.Context, d *schema.ResourceData, m
 	if err := updateNetworkDeviceLinkResource(link, d); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := importer.SetUUIDIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
 	return diags
 }
 
@@ -11,6 +11,7 @@ import (
 
 	"github.com/equinix/ne-go"
 	"github.com/equinix/terraform-provider-equinix/internal/converters"
+	"github.com/equinix/terraform-provider-equinix/internal/network"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 )
@@ -563,3 +564,63 @@ func TestNetworkDevice_AdditionalBandwidthStatusWaitConfiguration(t *testing.T)
 	assert.Equal(t, timeout, waitConfig.Timeout, "Additional bandwidth status wait configuration timeout matches")
 	assert.Equal(t, delay, waitConfig.MinTimeout, "Additional bandwidth wait configuration min timeout matches")
 }
+
+func TestNetworkDevice_softwareUpgradeWaitConfiguration(t *testing.T) {
+	// given
+	deviceID := "test"
+	targetVersion := "17.11.01a"
+	responses := []*ne.Device{
+		{Status: ne.String(ne.DeviceStateProvisioned), Version: ne.String("17.09.04a")},
+		{Status: ne.String(network.DeviceStateSoftwareUpgradeInProgress), Version: ne.String("17.09.04a")},
+		{Status: ne.String(ne.DeviceStateProvisioned), Version: ne.String(targetVersion)},
+	}
+	var queriedDeviceID string
+	calls := 0
+	fetchFunc := func(uuid string) (*ne.Device, error) {
+		queriedDeviceID = uuid
+		resp := responses[calls]
+		calls++
+		return resp, nil
+	}
+	delay := 10 * time.Millisecond
+	timeout := 10 * time.Minute
+	// when
+	waitConfig := createNetworkDeviceSoftwareUpgradeWaitConfiguration(fetchFunc, deviceID, targetVersion, delay, timeout)
+	waitConfig.PollInterval = delay
+	result, err := waitConfig.WaitForStateContext(context.Background())
+	// then
+	assert.Nil(t, err, "WaitForState does not return an error")
+	assert.Equal(t, deviceID, queriedDeviceID, "Queried device ID matches")
+	assert.Equal(t, len(responses), calls, "Device is polled until it runs the target version")
+	assert.Equal(t, targetVersion, ne.StringValue(result.(*ne.Device).Version), "Upgraded device version matches")
+	assert.Equal(t, timeout, waitConfig.Timeout, "Device status wait configuration timeout matches")
+	assert.Equal(t, delay, waitConfig.MinTimeout, "Device status wait configuration min timeout matches")
+}
+
+func TestNetworkDevice_softwareUpgradeWaitConfigurationFailed(t *testing.T) {
+	// given
+	fetchFunc := func(_ string) (*ne.Device, error) {
+		return &ne.Device{Status: ne.String(network.DeviceStateSoftwareUpgradeFailed), Version: ne.String("17.09.04a")}, nil
+	}
+	// when
+	waitConfig := createNetworkDeviceSoftwareUpgradeWaitConfiguration(fetchFunc, "test", "17.11.01a", 10*time.Millisecond, time.Minute)
+	_, err := waitConfig.WaitForStateContext(context.Background())
+	// then
+	assert.ErrorContains(t, err, network.DeviceStateSoftwareUpgradeFailed, "Failed upgrade stops waiting with an error")
+}
+
+func TestNetworkDevice_validateSoftwareVersion(t *testing.T) {
+	// given
+	versions := []ne.DeviceSoftwareVersion{
+		{Version: ne.String("17.09.04a"), PackageCodes: []string{"network-essentials", "network-advantage"}},
+		{Version: ne.String("17.11.01a"), PackageCodes: []string{"network-advantage"}},
+	}
+	// when
+	validErr := validateNetworkDeviceSoftwareVersion(versions, "C8000V", "network-advantage", "17.11.01a")
+	packageErr := validateNetworkDeviceSoftwareVersion(versions, "C8000V", "network-essentials", "17.11.01a")
+	missingErr := validateNetworkDeviceSoftwareVersion(versions, "C8000V", "network-essentials", "99.1")
+	// then
+	assert.Nil(t, validErr, "Available version passes validation")
+	assert.ErrorContains(t, packageErr, "does not support package", "Version without package support fails validation")
+	assert.ErrorContains(t, missingErr, "is not available", "Unknown version fails validation")
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/equinix/ne-go"
 	"github.com/equinix/rest-go"
 	"github.com/equinix/terraform-provider-equinix/internal/config"
+	"github.com/equinix/terraform-provider-equinix/internal/importer"
 	equinix_validation "github.com/equinix/terraform-provider-equinix/internal/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -44,11 +45,10 @@ func resourceNetworkFile() *schema.Resource {
 		CreateContext: resourceNetworkFileCreate,
 		ReadContext:   resourceNetworkFileRead,
 		DeleteContext: resourceNetworkFileDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
-		Schema:      createNetworkFileSchema(),
-		Description: "Resource allows creation and management of Equinix Network Edge device files",
+		Importer:      importer.UUIDImporter(),
+		Identity:      importer.UUIDIdentity(),
+		Schema:        createNetworkFileSchema(),
+		Description:   "Resource allows creation and management of Equinix Network Edge device files",
 	}
 }
 
@@ -146,6 +146,9 @@ func resourceNetworkFileRead(ctx context.Context, d *schema.ResourceData, m any)
 	if err := updateFileResource(file, d); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := importer.SetUUIDIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
 	return diags
 }
 
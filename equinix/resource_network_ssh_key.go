@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/equinix/terraform-provider-equinix/internal/config"
+	"github.com/equinix/terraform-provider-equinix/internal/importer"
 
 	"github.com/equinix/ne-go"
 	"github.com/equinix/rest-go"
@@ -36,10 +37,9 @@ func resourceNetworkSSHKey() *schema.Resource {
 		CreateContext: resourceNetworkSSHKeyCreate,
 		ReadContext:   resourceNetworkSSHKeyRead,
 		DeleteContext: resourceNetworkSSHKeyDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
-		Schema: createNetworkSSHKeyResourceSchema(),
+		Importer:      importer.UUIDImporter(),
+		Identity:      importer.UUIDIdentity(),
+		Schema:        createNetworkSSHKeyResourceSchema(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 		},
@@ -118,6 +118,9 @@ func resourceNetworkSSHKeyRead(ctx context.Context, d *schema.ResourceData, m an
 	if err := updateNetworkSSHKeyResource(key, d); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := importer.SetUUIDIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
 	return diags
 }
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/equinix/terraform-provider-equinix/internal/config"
 	"github.com/equinix/terraform-provider-equinix/internal/converters"
+	"github.com/equinix/terraform-provider-equinix/internal/importer"
 
 	"github.com/equinix/ne-go"
 	"github.com/hashicorp/go-cty/cty"
@@ -25,7 +26,7 @@ var networkSSHUserDescriptions = map[string]string{
 	"UUID":        "SSH user unique identifier",
 	"Username":    "SSH user login name",
 	"Password":    "SSH user password",
-	"DeviceUUIDs": "list of device identifiers to which user will have access",
+	"DeviceUUIDs": "list of device identifiers to which user will have access. At least one device is required when the user is created. Only the listed devices are tracked, devices assigned to the user elsewhere, e.g. with equinix_network_ssh_user_device, are ignored",
 }
 
 func resourceNetworkSSHUser() *schema.Resource {
@@ -34,11 +35,10 @@ func resourceNetworkSSHUser() *schema.Resource {
 		ReadContext:   resourceNetworkSSHUserRead,
 		UpdateContext: resourceNetworkSSHUserUpdate,
 		DeleteContext: resourceNetworkSSHUserDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
-		Schema:      createNetworkSSHUserResourceSchema(),
-		Description: "Resource allows creation and management of Equinix Network Edge SSH users",
+		Importer:      importer.UUIDImporter(),
+		Identity:      importer.UUIDIdentity(),
+		Schema:        createNetworkSSHUserResourceSchema(),
+		Description:   "Resource allows creation and management of Equinix Network Edge SSH users",
 	}
 }
 
@@ -65,7 +65,8 @@ func createNetworkSSHUserResourceSchema() map[string]*schema.Schema {
 		},
 		networkSSHUserSchemaNames["DeviceUUIDs"]: {
 			Type:     schema.TypeSet,
-			Required: true,
+			Optional: true,
+			Computed: true,
 			MinItems: 1,
 			Elem: &schema.Schema{
 				Type:         schema.TypeString,
@@ -82,7 +83,7 @@ func resourceNetworkSSHUserCreate(ctx context.Context, d *schema.ResourceData, m
 
 	var diags diag.Diagnostics
 	user := createNetworkSSHUser(d)
-	if len(user.DeviceUUIDs) < 0 {
+	if len(user.DeviceUUIDs) == 0 {
 		return diag.Errorf("create ssh-user failed: user needs to have at least one device defined")
 	}
 	uuid, err := client.CreateSSHUser(ne.StringValue(user.Username), ne.StringValue(user.Password), user.DeviceUUIDs[0])
@@ -115,6 +116,9 @@ func resourceNetworkSSHUserRead(ctx context.Context, d *schema.ResourceData, m a
 	if err := updateNetworkSSHUserResource(user, d); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := importer.SetUUIDIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
 	return diags
 }
 
@@ -178,8 +182,26 @@ func updateNetworkSSHUserResource(user *ne.SSHUser, d *schema.ResourceData) erro
 			return fmt.Errorf("error reading Password: %s", err)
 		}
 	}
-	if err := d.Set(networkSSHUserSchemaNames["DeviceUUIDs"], user.DeviceUUIDs); err != nil {
+	if err := d.Set(networkSSHUserSchemaNames["DeviceUUIDs"], ownNetworkSSHUserDevices(user.DeviceUUIDs, d)); err != nil {
 		return fmt.Errorf("error reading DeviceUUIDs: %s", err)
 	}
 	return nil
 }
+
+// ownNetworkSSHUserDevices returns the devices of the user that the resource
+// configured, taken from its prior state or configuration. Devices assigned
+// to the user elsewhere, e.g. with equinix_network_ssh_user_device, are
+// ignored. All devices are returned when none is tracked yet, e.g. on import
+func ownNetworkSSHUserDevices(deviceUUIDs []string, d *schema.ResourceData) []string {
+	tracked, ok := d.Get(networkSSHUserSchemaNames["DeviceUUIDs"]).(*schema.Set)
+	if !ok || tracked.Len() == 0 {
+		return deviceUUIDs
+	}
+	own := make([]string, 0, len(deviceUUIDs))
+	for _, deviceUUID := range deviceUUIDs {
+		if tracked.Contains(deviceUUID) {
+			own = append(own, deviceUUID)
+		}
+	}
+	return own
+}
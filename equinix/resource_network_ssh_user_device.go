@@ -0,0 +1,124 @@
+package equinix
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"slices"
+
+	"github.com/equinix/terraform-provider-equinix/internal/config"
+	"github.com/equinix/terraform-provider-equinix/internal/importer"
+
+	"github.com/equinix/ne-go"
+	"github.com/equinix/rest-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var networkSSHUserDeviceSchemaNames = map[string]string{
+	"UserID":   "user_id",
+	"DeviceID": "device_id",
+}
+
+var networkSSHUserDeviceDescriptions = map[string]string{
+	"UserID":   "Unique identifier of the SSH user",
+	"DeviceID": "Unique identifier of the device the SSH user is given access to",
+}
+
+func resourceNetworkSSHUserDevice() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkSSHUserDeviceCreate,
+		ReadContext:   resourceNetworkSSHUserDeviceRead,
+		DeleteContext: resourceNetworkSSHUserDeviceDelete,
+		Importer:      importer.CompositeImporter(networkSSHUserDeviceSchemaNames["UserID"], networkSSHUserDeviceSchemaNames["DeviceID"]),
+		Identity: importer.CompositeIdentity(map[string]string{
+			networkSSHUserDeviceSchemaNames["UserID"]:   networkSSHUserDeviceDescriptions["UserID"],
+			networkSSHUserDeviceSchemaNames["DeviceID"]: networkSSHUserDeviceDescriptions["DeviceID"],
+		}),
+		Schema:      createNetworkSSHUserDeviceResourceSchema(),
+		Description: "Resource allows assigning a single Equinix Network Edge device to an existing SSH user. The resource ID is composed of the user and device identifiers, in the form of user_id/device_id",
+	}
+}
+
+func createNetworkSSHUserDeviceResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		networkSSHUserDeviceSchemaNames["UserID"]: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  networkSSHUserDeviceDescriptions["UserID"],
+		},
+		networkSSHUserDeviceSchemaNames["DeviceID"]: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  networkSSHUserDeviceDescriptions["DeviceID"],
+		},
+	}
+}
+
+func resourceNetworkSSHUserDeviceCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*config.Config).Ne
+	m.(*config.Config).AddModuleToNEUserAgent(&client, d)
+	userID := d.Get(networkSSHUserDeviceSchemaNames["UserID"]).(string)
+	deviceID := d.Get(networkSSHUserDeviceSchemaNames["DeviceID"]).(string)
+	user, err := client.GetSSHUser(userID)
+	if err != nil {
+		return diag.Errorf("cannot fetch ssh user %q due to %v", userID, err)
+	}
+	if !slices.Contains(user.DeviceUUIDs, deviceID) {
+		updateReq := client.NewSSHUserUpdateRequest(userID)
+		updateReq.WithDeviceChange([]string{}, []string{deviceID})
+		if err := updateReq.Execute(); err != nil {
+			return diag.Errorf("error assigning network device %q to ssh user %q: %s", deviceID, userID, err)
+		}
+	}
+	d.SetId(importer.CompositeID(userID, deviceID))
+	return resourceNetworkSSHUserDeviceRead(ctx, d, m)
+}
+
+func resourceNetworkSSHUserDeviceRead(_ context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*config.Config).Ne
+	m.(*config.Config).AddModuleToNEUserAgent(&client, d)
+	var diags diag.Diagnostics
+	userID := d.Get(networkSSHUserDeviceSchemaNames["UserID"]).(string)
+	deviceID := d.Get(networkSSHUserDeviceSchemaNames["DeviceID"]).(string)
+	user, err := client.GetSSHUser(userID)
+	if err != nil {
+		if restErr, ok := err.(rest.Error); ok && restErr.HTTPCode == http.StatusNotFound {
+			log.Printf("[WARN] ssh user %q not found, removing device assignment %q from state", userID, d.Id())
+			d.SetId("")
+			return diags
+		}
+		return diag.Errorf("cannot fetch ssh user %q due to %v", userID, err)
+	}
+	if !slices.Contains(user.DeviceUUIDs, deviceID) {
+		log.Printf("[WARN] network device %q is no longer assigned to ssh user %q, removing from state", deviceID, userID)
+		d.SetId("")
+		return diags
+	}
+	if err := d.Set(networkSSHUserDeviceSchemaNames["UserID"], ne.StringValue(user.UUID)); err != nil {
+		return diag.Errorf("error reading UserID: %s", err)
+	}
+	if err := importer.SetCompositeIdentity(d, networkSSHUserDeviceSchemaNames["UserID"], networkSSHUserDeviceSchemaNames["DeviceID"]); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+func resourceNetworkSSHUserDeviceDelete(_ context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*config.Config).Ne
+	m.(*config.Config).AddModuleToNEUserAgent(&client, d)
+	var diags diag.Diagnostics
+	userID := d.Get(networkSSHUserDeviceSchemaNames["UserID"]).(string)
+	deviceID := d.Get(networkSSHUserDeviceSchemaNames["DeviceID"]).(string)
+	updateReq := client.NewSSHUserUpdateRequest(userID)
+	updateReq.WithDeviceChange([]string{deviceID}, []string{})
+	if err := updateReq.Execute(); err != nil {
+		return diag.Errorf("error removing network device %q from ssh user %q: %s", deviceID, userID, err)
+	}
+	return diags
+}
@@ -47,3 +47,18 @@ func TestNetworkSSHUser_updateResourceData(t *testing.T) {
 	assert.Equal(t, ne.StringValue(input.Password), d.Get(networkSSHUserSchemaNames["Password"]), "Password matches")
 	assert.Equal(t, input.DeviceUUIDs, converters.SetToStringList(d.Get(networkSSHUserSchemaNames["DeviceUUIDs"]).(*schema.Set)), "DeviceUUIDs matches")
 }
+
+func TestNetworkSSHUser_updateResourceDataIgnoresUntrackedDevices(t *testing.T) {
+	// given
+	d := schema.TestResourceDataRaw(t, createNetworkSSHUserResourceSchema(), make(map[string]any))
+	d.Set(networkSSHUserSchemaNames["DeviceUUIDs"], []string{"52c00d7f-c310-458e-9426-1d7549e1f600", "9a4be8d4-0e31-4f0a-8e44-fc1e3f6a0b6e"})
+	input := ne.SSHUser{
+		Username:    ne.String("user"),
+		DeviceUUIDs: []string{"52c00d7f-c310-458e-9426-1d7549e1f600", "5f1483f4-c479-424d-98c5-43a266aae25c"},
+	}
+	// when
+	err := updateNetworkSSHUserResource(&input, d)
+	// then
+	assert.Nil(t, err, "Update of resource data does not return error")
+	assert.ElementsMatch(t, []string{"52c00d7f-c310-458e-9426-1d7549e1f600"}, converters.SetToStringList(d.Get(networkSSHUserSchemaNames["DeviceUUIDs"]).(*schema.Set)), "Devices assigned elsewhere are ignored, removed tracked devices show up as drift")
+}
@@ -0,0 +1,11 @@
+data "equinix_fabric_cloud_router_package" "standard" {
+  code = "STANDARD"
+}
+
+output "standard_ipv4_routes_max" {
+  value = data.equinix_fabric_cloud_router_package.standard.total_ipv4_routes_max
+}
+
+output "standard_vc_bandwidth_max" {
+  value = data.equinix_fabric_cloud_router_package.standard.vc_bandwidth_max
+}
@@ -0,0 +1,5 @@
+data "equinix_fabric_cloud_router_packages" "all" {}
+
+output "route_filter_package_codes" {
+  value = [for package in data.equinix_fabric_cloud_router_packages.all.data : package.code if package.route_filter_supported]
+}
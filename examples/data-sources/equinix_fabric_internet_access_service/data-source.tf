@@ -0,0 +1,11 @@
+data "equinix_fabric_internet_access_service" "by_id" {
+  service_id = "<uuid_of_internet_access_service>"
+}
+
+output "internet_access_service_state" {
+  value = data.equinix_fabric_internet_access_service.by_id.state
+}
+
+output "internet_access_service_bandwidth" {
+  value = data.equinix_fabric_internet_access_service.by_id.bandwidth
+}
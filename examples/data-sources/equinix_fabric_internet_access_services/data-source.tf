@@ -0,0 +1,22 @@
+data "equinix_fabric_internet_access_services" "provisioned" {
+  filters = [
+    {
+      property = "/state"
+      operator = "="
+      values   = ["PROVISIONED"]
+    },
+    {
+      property = "/project/projectId"
+      operator = "="
+      values   = ["<project_id>"]
+    }
+  ]
+  pagination = {
+    offset = 0
+    limit  = 20
+  }
+}
+
+output "internet_access_service_names" {
+  value = [for service in data.equinix_fabric_internet_access_services.provisioned.data : service.name]
+}
@@ -0,0 +1,14 @@
+data "equinix_fabric_market_place_subscriptions" "aws_fcr_subscriptions" {
+  uuids       = ["<uuid_of_marketplace_subscription_1>", "<uuid_of_marketplace_subscription_2>"]
+  marketplace = "AWS"
+  status      = "ACTIVE"
+  asset_type  = "XF_ROUTER"
+}
+
+output "first_subscription_id" {
+  value = data.equinix_fabric_market_place_subscriptions.aws_fcr_subscriptions.data.0.uuid
+}
+
+output "first_subscription_quantity_available" {
+  value = data.equinix_fabric_market_place_subscriptions.aws_fcr_subscriptions.data.0.entitlements.0.quantity_available
+}
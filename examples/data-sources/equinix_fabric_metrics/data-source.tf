@@ -0,0 +1,17 @@
+data "equinix_fabric_metrics" "connection_bandwidth" {
+  asset           = "connections"
+  asset_id        = equinix_fabric_connection.example.id
+  name            = "equinix.fabric.connection.bandwidth_rx.usage"
+  start_date_time = timeadd(timestamp(), "-1h")
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.data) > 0 && length(self.data[0].datapoints) > 0
+      error_message = "Connection is not emitting bandwidth metrics yet"
+    }
+  }
+}
+
+output "bandwidth_datapoints" {
+  value = data.equinix_fabric_metrics.connection_bandwidth.data[0].datapoints
+}
@@ -0,0 +1,11 @@
+data "equinix_fabric_network_changes" "network_changes" {
+  network_id = "<uuid_of_network>"
+}
+
+output "latest_change_type" {
+  value = data.equinix_fabric_network_changes.network_changes.data.0.type
+}
+
+output "latest_change_status" {
+  value = data.equinix_fabric_network_changes.network_changes.data.0.status
+}
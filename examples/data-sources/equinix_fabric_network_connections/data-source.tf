@@ -0,0 +1,11 @@
+data "equinix_fabric_network_connections" "network_connections" {
+  network_id = "<uuid_of_network>"
+}
+
+output "connection_names" {
+  value = [for connection in data.equinix_fabric_network_connections.network_connections.data : connection.name]
+}
+
+output "connection_states" {
+  value = { for connection in data.equinix_fabric_network_connections.network_connections.data : connection.uuid => connection.state }
+}
@@ -0,0 +1,12 @@
+data "equinix_fabric_port_packages" "colo_sv" {
+  metro_code  = "SV"
+  source_type = "COLO"
+}
+
+output "port_package_codes" {
+  value = [for package in data.equinix_fabric_port_packages.colo_sv.data : package.code]
+}
+
+output "port_package_vc_bandwidth_max" {
+  value = { for package in data.equinix_fabric_port_packages.colo_sv.data : package.code => package.vc_bandwidth_max }
+}
@@ -0,0 +1,20 @@
+data "equinix_fabric_port_statistics" "last_day" {
+  port_id         = "<uuid_of_port>"
+  start_date_time = timeadd(plantimestamp(), "-24h")
+  metric_names = [
+    "equinix.fabric.port.bandwidth_rx.usage",
+    "equinix.fabric.port.bandwidth_tx.usage",
+  ]
+}
+
+output "port_operational_status" {
+  value = data.equinix_fabric_port_statistics.last_day.operational_status
+}
+
+output "physical_ports_operational_status" {
+  value = { for physical_port in data.equinix_fabric_port_statistics.last_day.physical_ports : physical_port.uuid => physical_port.operational_status }
+}
+
+output "port_bandwidth_summary" {
+  value = { for metric in data.equinix_fabric_port_statistics.last_day.metrics : metric.name => metric.summary }
+}
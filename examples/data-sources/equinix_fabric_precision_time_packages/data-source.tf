@@ -0,0 +1,12 @@
+data "equinix_fabric_precision_time_packages" "ptp" {
+  type       = "PTP"
+  metro_code = "SV"
+}
+
+output "ptp_package_codes" {
+  value = [for ptp_package in data.equinix_fabric_precision_time_packages.ptp.data : ptp_package.code]
+}
+
+output "ptp_package_monthly_charges" {
+  value = { for ptp_package in data.equinix_fabric_precision_time_packages.ptp.data : ptp_package.code => ptp_package.price.monthly_recurring_charge }
+}
@@ -0,0 +1,24 @@
+data "equinix_fabric_precision_time_status" "ept" {
+  ept_service_id = "<ept_service_id>"
+  history_limit  = 10
+}
+
+output "ept_operational_status" {
+  value = data.equinix_fabric_precision_time_status.ept.operation.operational_status
+}
+
+output "ept_connection_status" {
+  value = [for connection in data.equinix_fabric_precision_time_status.ept.connections : connection.operational_status]
+}
+
+# Gate a time-sensitive deployment on the synchronization health of the service
+resource "terraform_data" "trading_engine" {
+  input = "trading-engine"
+
+  lifecycle {
+    precondition {
+      condition     = data.equinix_fabric_precision_time_status.ept.operation.operational_status == "UP"
+      error_message = "Precision Time Service is not synchronized"
+    }
+  }
+}
@@ -0,0 +1,16 @@
+data "equinix_fabric_stream_alert_rule_templates" "connection" {
+  asset_type = "connections"
+  asset_id   = "<connection_id>"
+}
+
+resource "equinix_fabric_stream_alert_rule" "connection" {
+  for_each = { for template in data.equinix_fabric_stream_alert_rule_templates.connection.data : template.name => template }
+
+  stream_id         = "<stream_id>"
+  name              = each.key
+  type              = each.value.type
+  description       = each.value.description
+  metric_selector   = each.value.metric_selector
+  resource_selector = each.value.resource_selector
+  detection_method  = each.value.detection_method
+}
@@ -0,0 +1,11 @@
+data "equinix_fabric_stream_events" "connection_provisioned" {
+  asset           = "connections"
+  asset_id        = equinix_fabric_connection.example.id
+  type            = "equinix.fabric.connection.state.provisioned"
+  start_date_time = "2025-01-01T00:00:00Z"
+  limit           = 10
+}
+
+output "provisioned_at" {
+  value = data.equinix_fabric_stream_events.connection_provisioned.data[0].time
+}
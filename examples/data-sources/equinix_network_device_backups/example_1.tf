@@ -0,0 +1,9 @@
+# List completed configuration backups of a device
+data "equinix_network_device_backups" "completed" {
+  device_id = equinix_network_device.csr1000v-ha.id
+  statuses  = ["COMPLETED"]
+}
+
+output "latest_backup_id" {
+  value = data.equinix_network_device_backups.completed.backups[0].uuid
+}
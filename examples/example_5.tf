@@ -0,0 +1,18 @@
+# Configuration for using a workload identity token that is rotated on disk,
+# such as a Kubernetes projected service account token
+provider "equinix" {
+  token_exchange_scope = "roleassignments:<organization_id>"
+
+  # The file is re-read whenever a new token exchange is needed
+  token_exchange_subject_token_file = "/var/run/secrets/tokens/equinix-token"
+}
+
+# Configuration for using the GitHub Actions OIDC provider
+# The workflow must be granted the `id-token: write` permission
+provider "equinix" {
+  alias                = "github"
+  token_exchange_scope = "roleassignments:<organization_id>"
+
+  token_exchange_github_actions_oidc     = true
+  token_exchange_github_actions_audience = "<audience_trusted_by_equinix_sts>"
+}
@@ -0,0 +1,48 @@
+resource "equinix_fabric_connection" "port2port" {
+  name = "ConnectionName"
+  type = "EVPL_VC"
+  notifications {
+    type   = "ALL"
+    emails = ["example@equinix.com", "test1@equinix.com"]
+  }
+  bandwidth = 50
+  bandwidth_schedule {
+    start_date_time = "2026-11-27T00:00:00Z"
+    end_date_time   = "2026-12-01T00:00:00Z"
+    bandwidth       = 1000
+  }
+  order {
+    purchase_order_number = "1-323292"
+  }
+  a_side {
+    access_point {
+      type = "COLO"
+      port {
+        uuid = "<aside_port_uuid>"
+      }
+      link_protocol {
+        type       = "QINQ"
+        vlan_s_tag = "1976"
+      }
+    }
+  }
+  z_side {
+    access_point {
+      type = "COLO"
+      port {
+        uuid = "<zside_port_uuid>"
+      }
+      link_protocol {
+        type       = "QINQ"
+        vlan_s_tag = "3711"
+      }
+      location {
+        metro_code = "SV"
+      }
+    }
+  }
+}
+
+output "effective_bandwidth" {
+  value = equinix_fabric_connection.port2port.effective_bandwidth
+}
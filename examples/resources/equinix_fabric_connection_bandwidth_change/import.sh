@@ -0,0 +1,2 @@
+# Fabric connection bandwidth changes are imported using the composite ID <connection_id>/<previous_bandwidth>
+terraform import equinix_fabric_connection_bandwidth_change.example <connection_id>/<previous_bandwidth>
@@ -0,0 +1,17 @@
+resource "equinix_fabric_connection" "port2port" {
+  # ...
+  bandwidth = 50
+
+  lifecycle {
+    ignore_changes = [bandwidth]
+  }
+}
+
+resource "equinix_fabric_connection_bandwidth_change" "maintenance" {
+  connection_id = equinix_fabric_connection.port2port.id
+  bandwidth     = 1000
+}
+
+output "previous_bandwidth" {
+  value = equinix_fabric_connection_bandwidth_change.maintenance.previous_bandwidth
+}
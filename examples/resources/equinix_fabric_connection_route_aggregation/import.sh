@@ -0,0 +1,2 @@
+# Fabric route aggregation attachments are imported using the composite ID <connection_id>/<route_aggregation_id>
+terraform import equinix_fabric_connection_route_aggregation.example <connection_id>/<route_aggregation_id>
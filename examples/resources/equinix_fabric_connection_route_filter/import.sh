@@ -0,0 +1,2 @@
+# Fabric route filter policy attachments are imported using the composite ID <connection_id>/<route_filter_id>
+terraform import equinix_fabric_connection_route_filter.example <connection_id>/<route_filter_id>
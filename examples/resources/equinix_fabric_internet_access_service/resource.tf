@@ -0,0 +1,36 @@
+resource "equinix_fabric_internet_access_service" "bgp" {
+  type      = "SINGLE_IA"
+  name      = "<name_of_internet_access_service>"
+  bandwidth = 100
+  billing = {
+    type = "FIXED"
+  }
+  project = {
+    project_id = "<project_id>"
+  }
+  account = {
+    account_number = "<account_number>"
+  }
+  order = {
+    purchase_order_number = "<purchase_order_number>"
+  }
+  routing_protocol = {
+    type           = "BGP"
+    ip_block_uuids = ["<uuid_of_ip_block>"]
+    connections = [
+      {
+        uuid = "<uuid_of_internet_access_connection>"
+      }
+    ]
+    export_policy = "FULL"
+    customer_asn  = 65001
+  }
+}
+
+output "internet_access_service_state" {
+  value = equinix_fabric_internet_access_service.bgp.state
+}
+
+output "internet_access_peering" {
+  value = equinix_fabric_internet_access_service.bgp.routing_protocol.connections[0].peering_ipv4
+}
@@ -0,0 +1,79 @@
+resource "equinix_fabric_redundant_connection" "port2aws" {
+  type      = "EVPL_VC"
+  bandwidth = 50
+  notifications {
+    type   = "ALL"
+    emails = ["example@equinix.com", "test1@equinix.com"]
+  }
+  order {
+    purchase_order_number = "1-323292"
+  }
+
+  primary {
+    name = "ConnectionName-Pri"
+    a_side {
+      access_point {
+        type = "COLO"
+        port {
+          uuid = "<primary_port_uuid>"
+        }
+        link_protocol {
+          type     = "DOT1Q"
+          vlan_tag = "1234"
+        }
+      }
+    }
+    z_side {
+      access_point {
+        type               = "SP"
+        authentication_key = "<aws_account_id>"
+        seller_region      = "us-west-1"
+        profile {
+          type = "L2_PROFILE"
+          uuid = "<service_profile_uuid>"
+        }
+        location {
+          metro_code = "SV"
+        }
+      }
+    }
+  }
+
+  secondary {
+    name = "ConnectionName-Sec"
+    a_side {
+      access_point {
+        type = "COLO"
+        port {
+          uuid = "<secondary_port_uuid>"
+        }
+        link_protocol {
+          type     = "DOT1Q"
+          vlan_tag = "1235"
+        }
+      }
+    }
+    z_side {
+      access_point {
+        type               = "SP"
+        authentication_key = "<aws_account_id>"
+        seller_region      = "us-west-1"
+        profile {
+          type = "L2_PROFILE"
+          uuid = "<service_profile_uuid>"
+        }
+        location {
+          metro_code = "SV"
+        }
+      }
+    }
+  }
+}
+
+output "primary_connection_id" {
+  value = equinix_fabric_redundant_connection.port2aws.primary_connection_id
+}
+
+output "secondary_connection_id" {
+  value = equinix_fabric_redundant_connection.port2aws.secondary_connection_id
+}
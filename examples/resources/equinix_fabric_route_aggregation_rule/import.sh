@@ -0,0 +1,2 @@
+# Fabric route aggregation rules are imported using the composite ID <route_aggregation_id>/<id>
+terraform import equinix_fabric_route_aggregation_rule.example <route_aggregation_id>/<id>
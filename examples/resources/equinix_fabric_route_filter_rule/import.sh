@@ -0,0 +1,2 @@
+# Fabric route filter rules are imported using the composite ID <route_filter_id>/<id>
+terraform import equinix_fabric_route_filter_rule.example <route_filter_id>/<id>
@@ -0,0 +1,2 @@
+# Fabric routing protocols are imported using the composite ID <connection_uuid>/<id>
+terraform import equinix_fabric_routing_protocol.example <connection_uuid>/<id>
@@ -0,0 +1,38 @@
+resource "equinix_fabric_service_token" "test" {
+  type        = "VC_TOKEN"
+  description = "Zside COLO Service Token"
+  auto_extend {
+    threshold_days = 7
+    extension_days = 30
+  }
+  resend_notification_triggers = {
+    partner_contact = "partner@example.com"
+  }
+  service_token_connection {
+    type                 = "EVPL_VC"
+    supported_bandwidths = [50, 200, 10000]
+    z_side {
+      access_point_selectors {
+        type = "COLO"
+        port {
+          uuid = "<port_uuid>"
+        }
+        link_protocol {
+          type     = "DOT1Q"
+          vlan_tag = "2087"
+        }
+      }
+    }
+  }
+  notifications {
+    type   = "ALL"
+    emails = ["partner@example.com"]
+  }
+
+  lifecycle {
+    postcondition {
+      condition     = self.expires_in_days > 0
+      error_message = "Service token ${self.uuid} has expired"
+    }
+  }
+}
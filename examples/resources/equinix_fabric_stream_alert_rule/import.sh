@@ -0,0 +1,2 @@
+# Fabric stream alert rules are imported using the composite ID <stream_id>/<id>
+terraform import equinix_fabric_stream_alert_rule.example <stream_id>/<id>
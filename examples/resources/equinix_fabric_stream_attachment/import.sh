@@ -0,0 +1,2 @@
+# Fabric stream attachments are imported using the composite ID <stream_id>/<asset>/<asset_id>
+terraform import equinix_fabric_stream_attachment.example <stream_id>/<asset>/<asset_id>
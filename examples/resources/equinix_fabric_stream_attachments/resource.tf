@@ -0,0 +1,9 @@
+resource "equinix_fabric_stream_attachments" "production" {
+  stream_id       = "<id_of_the_stream_assets_are_being_attached_to>"
+  metrics_enabled = true
+  selector = {
+    project_id   = "<id_of_the_project_the_assets_belong_to>"
+    asset_type   = "connections"
+    name_pattern = "^prod-"
+  }
+}
@@ -0,0 +1,2 @@
+# Fabric stream subscriptions are imported using the composite ID <stream_id>/<id>
+terraform import equinix_fabric_stream_subscription.example <stream_id>/<id>
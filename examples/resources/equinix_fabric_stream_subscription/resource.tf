@@ -23,6 +23,7 @@ resource "equinix_fabric_stream_subscription" "SPLUNK" {
       access_token = "<splunk_access_token>"
     }
   }
+  validate_sink = true
 }
 
 resource "equinix_fabric_stream_subscription" "SLACK" {
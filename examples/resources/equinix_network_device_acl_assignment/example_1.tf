@@ -0,0 +1,13 @@
+# Assign WAN and management ACL templates to a device managed
+# in another configuration
+
+resource "equinix_network_device_acl_assignment" "wan" {
+  device_id       = "<uuid_of_device>"
+  acl_template_id = equinix_network_acl_template.wan.id
+}
+
+resource "equinix_network_device_acl_assignment" "mgmt" {
+  device_id       = "<uuid_of_device>"
+  type            = "MGMT"
+  acl_template_id = equinix_network_acl_template.mgmt.id
+}
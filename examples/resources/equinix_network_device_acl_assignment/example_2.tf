@@ -0,0 +1,7 @@
+# Assign a WAN ACL template to the second node of a device cluster
+
+resource "equinix_network_device_acl_assignment" "node1" {
+  device_id       = equinix_network_device.cluster.id
+  target          = "CLUSTER_NODE_1"
+  acl_template_id = equinix_network_acl_template.wan.id
+}
@@ -0,0 +1,11 @@
+# Reboot the secondary and then the primary device of a redundant pair.
+# Change the value of `reboot_requested_at` to reboot the devices again.
+resource "equinix_network_device_action" "reboot" {
+  device_id = equinix_network_device.csr1000v-ha.id
+  action    = "REBOOT"
+  target    = "ALL"
+
+  triggers = {
+    reboot_requested_at = "2024-06-01T10:00:00Z"
+  }
+}
@@ -0,0 +1,6 @@
+# Restore a device configuration from a backup
+resource "equinix_network_device_action" "restore" {
+  device_id = equinix_network_device.csr1000v-ha.id
+  action    = "RESTORE"
+  backup_id = equinix_network_device_backup.nightly.id
+}
@@ -0,0 +1,5 @@
+# Take a configuration backup of a device
+resource "equinix_network_device_backup" "nightly" {
+  device_id = equinix_network_device.csr1000v-ha.id
+  name      = "nightly-backup"
+}
@@ -0,0 +1,14 @@
+# Give an SSH user defined in another configuration access to a device
+# managed by this configuration
+
+data "terraform_remote_state" "platform" {
+  backend = "local"
+  config = {
+    path = "../platform/terraform.tfstate"
+  }
+}
+
+resource "equinix_network_ssh_user_device" "break_glass" {
+  user_id   = data.terraform_remote_state.platform.outputs.break_glass_user_id
+  device_id = equinix_network_device.csr1000v.uuid
+}
@@ -46,7 +46,7 @@ func init() {
 			Source: "hashicorp/random",
 		},
 	}
-	TestAccFrameworkProvider = provider.CreateFrameworkProvider(version.ProviderVersion).(*provider.FrameworkProvider)
+	TestAccFrameworkProvider = provider.CreateFrameworkProvider(version.ProviderVersion, equinix.ListResources()...).(*provider.FrameworkProvider)
 }
 
 // TestAccPreCheck verifies that the required environment variables are set
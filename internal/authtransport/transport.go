@@ -0,0 +1,138 @@
+// Package authtransport provides an http.RoundTripper that authorizes requests
+// with refreshable access tokens and transparently replays a request once when
+// the API rejects the token with a 401.
+package authtransport
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// DefaultRefreshSkew is how long before expiry a cached token is refreshed so
+// that long-running waiters do not send a token that expires in flight
+const DefaultRefreshSkew = 5 * time.Minute
+
+// TokenSource supplies access tokens for Transport
+type TokenSource interface {
+	// TokenWithContext returns a cached token or fetches a new one
+	TokenWithContext(ctx context.Context) (*oauth2.Token, error)
+	// Invalidate drops token from the cache so the next call to
+	// TokenWithContext fetches a new one
+	Invalidate(token *oauth2.Token)
+}
+
+// Transport is an http.RoundTripper that adds an Authorization header with a
+// token from Source. When the server responds with 401 Unauthorized the token
+// is invalidated and the request is replayed once with a fresh token.
+type Transport struct {
+	// Source supplies the token to add to outgoing requests'
+	// Authorization headers.
+	Source TokenSource
+
+	// Base is the base RoundTripper used to make HTTP requests.
+	// If nil, http.DefaultTransport is used.
+	Base http.RoundTripper
+}
+
+// RoundTrip authorizes and authenticates the request with an
+// access token from Transport's Source.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := bufferBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := t.Source.TokenWithContext(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base().RoundTrip(authorizedRequest(req, body, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	log.Printf("[DEBUG] received 401 for %s %s, refreshing access token and replaying request", req.Method, req.URL.Redacted())
+	t.Source.Invalidate(token)
+	refreshed, err := t.Source.TokenWithContext(req.Context())
+	if err != nil {
+		// Surface the original 401 so callers see the API response
+		log.Printf("[WARN] failed to refresh access token after 401: %v", err)
+		return resp, nil
+	}
+
+	drainAndClose(resp.Body)
+	return t.base().RoundTrip(authorizedRequest(req, body, refreshed))
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// RefreshAt returns the time at which a token issued at issuedAt and expiring
+// at expiry should be proactively refreshed. The skew is capped at half of the
+// token lifetime so that short-lived tokens are still reused.
+func RefreshAt(issuedAt, expiry time.Time, skew time.Duration) time.Time {
+	if expiry.IsZero() {
+		return time.Time{}
+	}
+	if lifetime := expiry.Sub(issuedAt); skew > lifetime/2 {
+		skew = lifetime / 2
+	}
+	return expiry.Add(-skew)
+}
+
+// NeedsRefresh reports whether token is missing, invalid or past refreshAt
+func NeedsRefresh(token *oauth2.Token, refreshAt time.Time) bool {
+	if !token.Valid() {
+		return true
+	}
+	return !refreshAt.IsZero() && !time.Now().Before(refreshAt)
+}
+
+// bufferBody reads the request body into memory so that it can be sent again
+// if the request has to be replayed. The original body is always closed.
+func bufferBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	defer req.Body.Close()
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading request body: %w", err)
+	}
+	return body, nil
+}
+
+// authorizedRequest returns a clone of req with its own copy of body and the
+// Authorization header set from token, per the RoundTripper contract
+func authorizedRequest(req *http.Request, body []byte, token *oauth2.Token) *http.Request {
+	req2 := req.Clone(req.Context())
+	if body != nil {
+		req2.Body = io.NopCloser(bytes.NewReader(body))
+		req2.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+		req2.ContentLength = int64(len(body))
+	}
+	token.SetAuthHeader(req2)
+	return req2
+}
+
+func drainAndClose(body io.ReadCloser) {
+	if body == nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(body, 4096))
+	_ = body.Close()
+}
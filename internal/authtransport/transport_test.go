@@ -0,0 +1,158 @@
+package authtransport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+// fakeTokenSource issues sequentially numbered tokens and records invalidations
+type fakeTokenSource struct {
+	mu          sync.Mutex
+	issued      int
+	current     *oauth2.Token
+	invalidated []string
+}
+
+func (f *fakeTokenSource) TokenWithContext(_ context.Context) (*oauth2.Token, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.current == nil {
+		f.issued++
+		f.current = &oauth2.Token{AccessToken: fmt.Sprintf("token-%d", f.issued), TokenType: "Bearer"}
+	}
+	return f.current, nil
+}
+
+func (f *fakeTokenSource) Invalidate(token *oauth2.Token) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.invalidated = append(f.invalidated, token.AccessToken)
+	f.current = nil
+}
+
+func TestTransport_ReplaysRequestOnceAfter401(t *testing.T) {
+	// given
+	var authHeaders, bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		authHeaders = append(authHeaders, r.Header.Get("Authorization"))
+		bodies = append(bodies, string(body))
+		if r.Header.Get("Authorization") == "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	source := &fakeTokenSource{}
+	client := &http.Client{Transport: &Transport{Source: source}}
+
+	// when
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"test"}`))
+
+	// then
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{"Bearer token-1", "Bearer token-2"}, authHeaders)
+	assert.Equal(t, []string{`{"name":"test"}`, `{"name":"test"}`}, bodies)
+	assert.Equal(t, []string{"token-1"}, source.invalidated)
+}
+
+func TestTransport_DoesNotReplayMoreThanOnce(t *testing.T) {
+	// given
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	source := &fakeTokenSource{}
+	client := &http.Client{Transport: &Transport{Source: source}}
+
+	// when
+	resp, err := client.Get(server.URL)
+
+	// then
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, []string{"token-1"}, source.invalidated)
+}
+
+func TestTransport_NoReplayOnSuccessOrOtherErrors(t *testing.T) {
+	// given
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	source := &fakeTokenSource{}
+	client := &http.Client{Transport: &Transport{Source: source}}
+
+	// when
+	resp, err := client.Get(server.URL)
+
+	// then
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.Equal(t, 1, requests)
+	assert.Empty(t, source.invalidated)
+}
+
+func TestRefreshAt(t *testing.T) {
+	issuedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		expiry   time.Time
+		skew     time.Duration
+		expected time.Time
+	}{
+		"no expiry": {
+			expiry:   time.Time{},
+			skew:     DefaultRefreshSkew,
+			expected: time.Time{},
+		},
+		"long lived token uses full skew": {
+			expiry:   issuedAt.Add(time.Hour),
+			skew:     5 * time.Minute,
+			expected: issuedAt.Add(55 * time.Minute),
+		},
+		"short lived token caps skew at half lifetime": {
+			expiry:   issuedAt.Add(4 * time.Minute),
+			skew:     5 * time.Minute,
+			expected: issuedAt.Add(2 * time.Minute),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, RefreshAt(issuedAt, tc.expiry, tc.skew))
+		})
+	}
+}
+
+func TestNeedsRefresh(t *testing.T) {
+	valid := &oauth2.Token{AccessToken: "token", Expiry: time.Now().Add(time.Hour)}
+
+	assert.True(t, NeedsRefresh(nil, time.Time{}), "missing token")
+	assert.True(t, NeedsRefresh(&oauth2.Token{AccessToken: "token", Expiry: time.Now().Add(-time.Minute)}, time.Time{}), "expired token")
+	assert.True(t, NeedsRefresh(valid, time.Now().Add(-time.Second)), "token past refresh time")
+	assert.False(t, NeedsRefresh(valid, time.Now().Add(time.Minute)), "token before refresh time")
+	assert.False(t, NeedsRefresh(&oauth2.Token{AccessToken: "token"}, time.Time{}), "token without expiry")
+}
@@ -0,0 +1,53 @@
+// Package clientcredentials implements the Equinix OAuth2 client credentials
+// flow with proactive token refresh and 401 replay for long-running applies.
+package clientcredentials
+
+import (
+	"sync"
+	"time"
+
+	"github.com/equinix/equinix-sdk-go/services/accesstokenv1"
+	"github.com/equinix/terraform-provider-equinix/internal/authtransport"
+)
+
+const (
+	defTokenTimeout = 3600
+)
+
+// Config describes oauth2 client credentials flow
+type Config struct {
+	// ClientID is the application's ID.
+	ClientID string
+	// ClientSecret is the application's secret.
+	ClientSecret string
+	// BaseURL is the base endpoint of a server that  token endpoint
+	BaseURL string
+	// RefreshSkew is how long before expiry a cached token is refreshed.
+	// Defaults to authtransport.DefaultRefreshSkew.
+	RefreshSkew time.Duration
+}
+
+// TokenSource returns a TokenSource that returns t until shortly before t
+// expires, automatically refreshing it as necessary using the provided context
+// and the client ID and client secret.
+func (c *Config) TokenSource() *ContextAwareTokenSource {
+	config := accesstokenv1.NewConfiguration()
+	config.Servers = accesstokenv1.ServerConfigurations{
+		accesstokenv1.ServerConfiguration{
+			URL: c.BaseURL,
+		},
+	}
+	restClient := accesstokenv1.NewAPIClient(config)
+	return &ContextAwareTokenSource{
+		conf:   c,
+		client: restClient,
+		mu:     sync.Mutex{},
+	}
+}
+
+// New creates an authtransport.Transport using the client credentials token source
+func (c *Config) New() *authtransport.Transport {
+	return &authtransport.Transport{
+		Source: c.TokenSource(),
+	}
+}
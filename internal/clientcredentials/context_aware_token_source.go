@@ -0,0 +1,85 @@
+package clientcredentials
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/equinix/equinix-sdk-go/services/accesstokenv1"
+	"github.com/equinix/terraform-provider-equinix/internal/authtransport"
+	"golang.org/x/oauth2"
+)
+
+// ContextAwareTokenSource fetches and caches client credentials access tokens
+type ContextAwareTokenSource struct {
+	conf   *Config
+	client *accesstokenv1.APIClient
+	mu     sync.Mutex
+	token  *oauth2.Token
+	// refreshAt is when the cached token is proactively refreshed
+	refreshAt time.Time
+}
+
+// TokenWithContext returns the cached token, fetching a new one when there is
+// no token or the cached token is within the refresh skew of its expiry
+func (s *ContextAwareTokenSource) TokenWithContext(ctx context.Context) (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !authtransport.NeedsRefresh(s.token, s.refreshAt) {
+		return s.token, nil
+	}
+
+	issuedAt := time.Now()
+	req := accesstokenv1.Oauth2TokenRequest{
+		GrantType:    accesstokenv1.PtrString("client_credentials"),
+		ClientId:     s.conf.ClientID,
+		ClientSecret: s.conf.ClientSecret,
+	}
+	result, _, err := s.client.OAuth2TokenApi.GetOAuth2AccessToken(ctx).Payload(req).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("oauth2: failed to fetch token: %s", err)
+	}
+
+	token := oauth2.Token{
+		AccessToken:  result.AccessToken,
+		TokenType:    "Bearer",
+		RefreshToken: result.GetRefreshToken(),
+	}
+
+	timeout, err := strconv.Atoi(result.TokenTimeout)
+	if err != nil {
+		timeout = defTokenTimeout
+	}
+	if timeout != 0 {
+		token.Expiry = issuedAt.Add(time.Duration(timeout) * time.Second)
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("oauth2: server response missing access token")
+	}
+
+	s.token = &token
+	s.refreshAt = authtransport.RefreshAt(issuedAt, token.Expiry, s.refreshSkew())
+	return s.token, nil
+}
+
+// Invalidate drops the cached token if it is still token, forcing the next
+// call to TokenWithContext to fetch a new one
+func (s *ContextAwareTokenSource) Invalidate(token *oauth2.Token) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && token != nil && s.token.AccessToken == token.AccessToken {
+		s.token = nil
+		s.refreshAt = time.Time{}
+	}
+}
+
+func (s *ContextAwareTokenSource) refreshSkew() time.Duration {
+	if s.conf.RefreshSkew > 0 {
+		return s.conf.RefreshSkew
+	}
+	return authtransport.DefaultRefreshSkew
+}
@@ -0,0 +1,141 @@
+package clientcredentials
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTokenServer is a local stand-in for the Equinix OAuth2 token endpoint
+type fakeTokenServer struct {
+	mu           sync.Mutex
+	issued       int
+	tokenTimeout string
+}
+
+func (f *fakeTokenServer) handler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/oauth2/v1/token", r.URL.Path)
+
+		f.mu.Lock()
+		f.issued++
+		accessToken := fmt.Sprintf("access-%d", f.issued)
+		f.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"access_token":  accessToken,
+			"token_timeout": f.tokenTimeout,
+			"user_name":     "user",
+			"token_type":    "Bearer",
+		})
+	}
+}
+
+func (f *fakeTokenServer) count() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.issued
+}
+
+func TestClientCredentials_CachesTokenOutsideRefreshSkew(t *testing.T) {
+	// given
+	fake := &fakeTokenServer{tokenTimeout: "3600"}
+	server := httptest.NewServer(fake.handler(t))
+	defer server.Close()
+
+	source := (&Config{ClientID: "id", ClientSecret: "secret", BaseURL: server.URL}).TokenSource()
+
+	// when
+	first, err := source.TokenWithContext(context.Background())
+	require.NoError(t, err)
+	second, err := source.TokenWithContext(context.Background())
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, "access-1", first.AccessToken)
+	assert.Same(t, first, second)
+	assert.Equal(t, 1, fake.count())
+}
+
+func TestClientCredentials_RefreshesWithinSkew(t *testing.T) {
+	// given
+	fake := &fakeTokenServer{tokenTimeout: "3600"}
+	server := httptest.NewServer(fake.handler(t))
+	defer server.Close()
+
+	source := (&Config{ClientID: "id", ClientSecret: "secret", BaseURL: server.URL, RefreshSkew: 30 * time.Minute}).TokenSource()
+	_, err := source.TokenWithContext(context.Background())
+	require.NoError(t, err)
+
+	// when
+	source.refreshAt = time.Now().Add(-time.Second)
+	refreshed, err := source.TokenWithContext(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "access-2", refreshed.AccessToken)
+	assert.True(t, refreshed.Valid())
+	assert.Equal(t, 2, fake.count())
+}
+
+func TestClientCredentials_Invalidate(t *testing.T) {
+	// given
+	fake := &fakeTokenServer{tokenTimeout: "3600"}
+	server := httptest.NewServer(fake.handler(t))
+	defer server.Close()
+
+	source := (&Config{ClientID: "id", ClientSecret: "secret", BaseURL: server.URL}).TokenSource()
+	stale, err := source.TokenWithContext(context.Background())
+	require.NoError(t, err)
+
+	// when
+	source.Invalidate(stale)
+	fresh, err := source.TokenWithContext(context.Background())
+	require.NoError(t, err)
+	source.Invalidate(stale)
+	cached, err := source.TokenWithContext(context.Background())
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, "access-2", fresh.AccessToken)
+	assert.Same(t, fresh, cached, "invalidating an old token must not drop a newer one")
+	assert.Equal(t, 2, fake.count())
+}
+
+func TestClientCredentials_TransportReplaysAfter401(t *testing.T) {
+	// given
+	fake := &fakeTokenServer{tokenTimeout: "3600"}
+	tokenServer := httptest.NewServer(fake.handler(t))
+	defer tokenServer.Close()
+
+	var authHeaders []string
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeaders = append(authHeaders, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") != "Bearer access-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer apiServer.Close()
+
+	client := &http.Client{Transport: (&Config{ClientID: "id", ClientSecret: "secret", BaseURL: tokenServer.URL}).New()}
+
+	// when
+	resp, err := client.Get(apiServer.URL)
+
+	// then
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{"Bearer access-1", "Bearer access-2"}, authHeaders)
+}
@@ -12,9 +12,9 @@ import (
 	"strings"
 	"time"
 
-	"github.com/equinix/equinix-sdk-go/extensions/equinixoauth2"
 	"github.com/equinix/equinix-sdk-go/services/fabricv4"
 	"github.com/equinix/ne-go"
+	"github.com/equinix/terraform-provider-equinix/internal/clientcredentials"
 	"github.com/equinix/terraform-provider-equinix/internal/sts"
 	"github.com/equinix/terraform-provider-equinix/version"
 	"github.com/hashicorp/go-retryablehttp"
@@ -36,6 +36,11 @@ const (
 	TokenExchangeSubjectTokenEnvVarEnvVar  = "EQUINIX_TOKEN_EXCHANGE_SUBJECT_TOKEN_ENV_VAR"
 	StsEndpointEnvVar                      = "EQUINIX_STS_ENDPOINT"
 	DefaultTokenExchangeSubjectTokenEnvVar = "EQUINIX_TOKEN_EXCHANGE_SUBJECT_TOKEN"
+	TokenExchangeSubjectTokenFileEnvVar    = "EQUINIX_TOKEN_EXCHANGE_SUBJECT_TOKEN_FILE"
+	TokenExchangeGitHubActionsOIDCEnvVar   = "EQUINIX_TOKEN_EXCHANGE_GITHUB_ACTIONS_OIDC"
+	TokenExchangeGitHubAudienceEnvVar      = "EQUINIX_TOKEN_EXCHANGE_GITHUB_ACTIONS_AUDIENCE"
+	GitHubActionsIDTokenRequestURLEnvVar   = "ACTIONS_ID_TOKEN_REQUEST_URL"
+	GitHubActionsIDTokenRequestTokenEnvVar = "ACTIONS_ID_TOKEN_REQUEST_TOKEN"
 )
 
 // ProviderMeta allows passing additional metadata
@@ -74,6 +79,9 @@ type Config struct {
 	StsBaseURL                      string
 	TokenExchangeSubjectToken       string
 	TokenExchangeSubjectTokenEnvVar string
+	TokenExchangeSubjectTokenFile   string
+	TokenExchangeGitHubActionsOIDC  bool
+	TokenExchangeGitHubAudience     string
 
 	authClient *http.Client
 
@@ -123,12 +131,12 @@ func (c *Config) Load(ctx context.Context) error {
 func (c *Config) newAuthClient() *http.Client {
 	var authTransport http.RoundTripper
 	if c.TokenExchangeScope != "" {
-		sourceToken := c.resolveSourceToken()
-		if sourceToken != "" {
+		subjectTokenSource := c.resolveSourceToken()
+		if subjectTokenSource != nil {
 			authConfig := sts.Config{
-				StsAuthScope:   c.TokenExchangeScope,
-				StsSourceToken: sourceToken,
-				StsBaseURL:     c.StsBaseURL,
+				StsAuthScope:       c.TokenExchangeScope,
+				SubjectTokenSource: subjectTokenSource,
+				StsBaseURL:         c.StsBaseURL,
 			}
 			authTransport = authConfig.New()
 		}
@@ -143,7 +151,7 @@ func (c *Config) newAuthClient() *http.Client {
 			}
 			authTransport = oauthTransport
 		} else {
-			authConfig := equinixoauth2.Config{
+			authConfig := clientcredentials.Config{
 				ClientID:     c.ClientID,
 				ClientSecret: c.ClientSecret,
 				BaseURL:      c.BaseURL,
@@ -160,18 +168,36 @@ func (c *Config) newAuthClient() *http.Client {
 	return &authClient
 }
 
-func (c *Config) resolveSourceToken() string {
+// resolveSourceToken determines where the STS subject token comes from. The
+// returned source is consulted on every token exchange, so file and GitHub
+// Actions tokens that rotate during long applies are re-read as needed.
+func (c *Config) resolveSourceToken() sts.SubjectTokenSource {
 	// First priority: explicitly configured token
 	if c.TokenExchangeSubjectToken != "" {
-		return c.TokenExchangeSubjectToken
+		return sts.StaticSubjectToken(c.TokenExchangeSubjectToken)
 	}
 
-	// Second priority: token from environment variable
-	if c.TokenExchangeSubjectTokenEnvVar != "" {
-		return os.Getenv(c.TokenExchangeSubjectTokenEnvVar)
+	// Second priority: token read from a file, e.g. a Kubernetes projected service account token
+	if c.TokenExchangeSubjectTokenFile != "" {
+		return sts.FileSubjectToken(c.TokenExchangeSubjectTokenFile)
 	}
 
-	return ""
+	// Third priority: token requested from the GitHub Actions OIDC provider
+	if c.TokenExchangeGitHubActionsOIDC {
+		return sts.GitHubActionsSubjectToken(
+			os.Getenv(GitHubActionsIDTokenRequestURLEnvVar),
+			os.Getenv(GitHubActionsIDTokenRequestTokenEnvVar),
+			c.TokenExchangeGitHubAudience,
+			&http.Client{Timeout: c.requestTimeout()},
+		)
+	}
+
+	// Fourth priority: token from environment variable
+	if c.TokenExchangeSubjectTokenEnvVar != "" && os.Getenv(c.TokenExchangeSubjectTokenEnvVar) != "" {
+		return sts.EnvSubjectToken(c.TokenExchangeSubjectTokenEnvVar)
+	}
+
+	return nil
 }
 
 // NewFabricClientForSDK returns a terraform sdkv2 plugin compatible
@@ -185,6 +211,17 @@ func (c *Config) NewFabricClientForSDK(_ context.Context, d *schema.ResourceData
 	return client
 }
 
+// NewFabricClientForSDKPlan returns a terraform sdkv2 plugin compatible
+// equinix-sdk-go/fabricv4 client to be used while planning changes, when the
+// provider_meta of the module is not available
+func (c *Config) NewFabricClientForSDKPlan(_ context.Context) *fabricv4.APIClient {
+	client := c.newFabricClient()
+
+	client.GetConfig().UserAgent = c.tfSdkUserAgent(client.GetConfig().UserAgent)
+
+	return client
+}
+
 // NewFabricClientForTesting is a shim for Fabric tests.
 // Deprecated: when the acceptance package starts to contain API clients for testing/cleanup this will move with them
 func (c *Config) NewFabricClientForTesting(_ context.Context) *fabricv4.APIClient {
@@ -206,6 +243,17 @@ func (c *Config) NewFabricClientForFramework(ctx context.Context, meta tfsdk.Con
 	return client
 }
 
+// NewFabricClientForList returns a terraform framework compatible
+// equinix-sdk-go/fabricv4 client to be used by list resources, which
+// are not given the provider_meta of the module being queried
+func (c *Config) NewFabricClientForList(_ context.Context) *fabricv4.APIClient {
+	client := c.newFabricClient()
+
+	client.GetConfig().UserAgent = c.tfFrameworkUserAgent(client.GetConfig().UserAgent)
+
+	return client
+}
+
 // newFabricClient returns the base fabricv4 client that is then used for either the sdkv2 or framework
 // implementations of the Terraform Provider with exported Methods
 func (c *Config) newFabricClient() *fabricv4.APIClient {
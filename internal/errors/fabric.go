@@ -0,0 +1,102 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// fabricNotFoundErrorCodes are the Fabric API error codes reported for
+// resources that do not exist or have already been deleted
+var fabricNotFoundErrorCodes = []string{
+	"EQ-3142509", // Connection already deleted
+	"EQ-3034019", // Service Token already deleted
+	"EQ-3041121", // Routing Protocol already deleted
+	"EQ-3044402", // Route Aggregation Rule already deleted
+}
+
+// fabricDeletedStates are the states in which the Fabric API keeps returning
+// resources after they have been deleted
+var fabricDeletedStates = []string{
+	"DEPROVISIONED",
+	"DELETED",
+}
+
+// FabricDeletedStateError is reported for Fabric resources that are returned
+// by the API in one of their deleted states
+type FabricDeletedStateError struct {
+	ID    string
+	State string
+}
+
+func (e *FabricDeletedStateError) Error() string {
+	return fmt.Sprintf("resource %s is in %s state", e.ID, e.State)
+}
+
+// CheckFabricDeletedState returns a FabricDeletedStateError when the given
+// state is one of the deleted states of Fabric resources
+func CheckFabricDeletedState(id, state string) error {
+	if slices.Contains(fabricDeletedStates, state) {
+		return &FabricDeletedStateError{ID: id, State: state}
+	}
+	return nil
+}
+
+// FabricErrors returns the errors reported in the body of a failed Fabric API
+// response
+func FabricErrors(err error) []fabricv4.Error {
+	var genericError *fabricv4.GenericOpenAPIError
+	if !errors.As(err, &genericError) {
+		return nil
+	}
+	switch model := genericError.Model().(type) {
+	case []fabricv4.Error:
+		return model
+	case fabricv4.Error:
+		return []fabricv4.Error{model}
+	}
+	return nil
+}
+
+// IsFabricNotFound reports whether a Fabric API request failed because the
+// resource does not exist or has already been deleted
+func IsFabricNotFound(resp *http.Response, err error) bool {
+	if err == nil {
+		return false
+	}
+	var deletedStateError *FabricDeletedStateError
+	if errors.As(err, &deletedStateError) {
+		return true
+	}
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return true
+	}
+	fabricErrs := FabricErrors(err)
+	return slices.ContainsFunc(fabricNotFoundErrorCodes, func(code string) bool {
+		return HasErrorCode(fabricErrs, code)
+	})
+}
+
+// FabricReadDiagnostics returns the diagnostics of a failed read of a Fabric
+// resource managed with terraform-plugin-sdk/v2. A resource that no longer
+// exists is removed from the state with a warning, any other error fails the
+// read
+func FabricReadDiagnostics(d *schema.ResourceData, resp *http.Response, err error) sdkdiag.Diagnostics {
+	if !IsFabricNotFound(resp, err) {
+		return sdkdiag.FromErr(FormatFabricError(err))
+	}
+	log.Printf("[WARN] Fabric resource %s not found, removing from state: %s", d.Id(), err)
+	d.SetId("")
+	warning := NewResourceNotFoundWarningDiagnostic(FormatFabricError(err))
+	return sdkdiag.Diagnostics{{
+		Severity: sdkdiag.Warning,
+		Summary:  warning.Summary(),
+		Detail:   warning.Detail(),
+	}}
+}
@@ -0,0 +1,120 @@
+package errors
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testFabricAPIError(t *testing.T, statusCode int, body string) (*http.Response, error) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		fmt.Fprint(w, body)
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := fabricv4.NewConfiguration()
+	cfg.Servers = fabricv4.ServerConfigurations{{URL: server.URL}}
+	client := fabricv4.NewAPIClient(cfg)
+	_, resp, err := client.ConnectionsApi.GetConnectionByUuid(context.Background(), "connection-uuid").Execute()
+	require.Error(t, err)
+	return resp, err
+}
+
+func TestIsFabricNotFound(t *testing.T) {
+	tests := map[string]struct {
+		statusCode int
+		body       string
+		expected   bool
+	}{
+		"not found": {
+			statusCode: http.StatusNotFound,
+			body:       `[{"errorCode":"EQ-3142102","errorMessage":"Connection not found"}]`,
+			expected:   true,
+		},
+		"already deleted": {
+			statusCode: http.StatusBadRequest,
+			body:       `[{"errorCode":"EQ-3142509","errorMessage":"Connection already deleted"}]`,
+			expected:   true,
+		},
+		"bad request": {
+			statusCode: http.StatusBadRequest,
+			body:       `[{"errorCode":"EQ-3142001","errorMessage":"Invalid request"}]`,
+		},
+		"internal server error": {
+			statusCode: http.StatusInternalServerError,
+			body:       `[{"errorCode":"EQ-3142000","errorMessage":"Internal error"}]`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			resp, err := testFabricAPIError(t, tc.statusCode, tc.body)
+
+			// when
+			result := IsFabricNotFound(resp, err)
+
+			// then
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestIsFabricNotFound_DeletedState(t *testing.T) {
+	// given
+	deleted := CheckFabricDeletedState("connection-uuid", "DEPROVISIONED")
+	active := CheckFabricDeletedState("connection-uuid", "ACTIVE")
+
+	// when
+	result := IsFabricNotFound(nil, deleted)
+
+	// then
+	assert.True(t, result)
+	assert.EqualError(t, deleted, "resource connection-uuid is in DEPROVISIONED state")
+	assert.NoError(t, active)
+	assert.False(t, IsFabricNotFound(nil, active))
+}
+
+func TestFabricReadDiagnostics(t *testing.T) {
+	tests := map[string]struct {
+		statusCode int
+		severity   sdkdiag.Severity
+		id         string
+	}{
+		"not found removes the resource with a warning": {
+			statusCode: http.StatusNotFound,
+			severity:   sdkdiag.Warning,
+			id:         "",
+		},
+		"other errors keep the resource": {
+			statusCode: http.StatusInternalServerError,
+			severity:   sdkdiag.Error,
+			id:         "connection-uuid",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]any{})
+			d.SetId("connection-uuid")
+			resp, err := testFabricAPIError(t, tc.statusCode, `[{"errorCode":"EQ-3142000"}]`)
+
+			// when
+			diags := FabricReadDiagnostics(d, resp, err)
+
+			// then
+			require.Len(t, diags, 1)
+			assert.Equal(t, tc.severity, diags[0].Severity)
+			assert.Equal(t, tc.id, d.Id())
+		})
+	}
+}
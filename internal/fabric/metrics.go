@@ -0,0 +1,83 @@
+package fabric
+
+import (
+	"context"
+	"strings"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PortMetricNames are the metrics reported for Fabric ports
+var PortMetricNames = []string{
+	"equinix.fabric.port.bandwidth_rx.usage",
+	"equinix.fabric.port.bandwidth_tx.usage",
+	"equinix.fabric.port.packets_dropped_rx.count",
+	"equinix.fabric.port.packets_dropped_tx.count",
+	"equinix.fabric.port.packets_erred_rx.count",
+	"equinix.fabric.port.packets_erred_tx.count",
+}
+
+// ConnectionMetricNames are the metrics reported for Fabric connections
+var ConnectionMetricNames = []string{
+	"equinix.fabric.connection.bandwidth_rx.usage",
+	"equinix.fabric.connection.bandwidth_tx.usage",
+	"equinix.fabric.connection.packets_dropped_rx_aside_ratelimit.count",
+	"equinix.fabric.connection.packets_dropped_tx_aside_ratelimit.count",
+	"equinix.fabric.connection.packets_dropped_rx_zside_ratelimit.count",
+	"equinix.fabric.connection.packets_dropped_tx_zside_ratelimit.count",
+}
+
+// FormatMetricNames formats metric names as a comma separated list of code
+// spans, for them to be listed in schema descriptions
+func FormatMetricNames(names []string) string {
+	return "`" + strings.Join(names, "`, `") + "`"
+}
+
+// MetricDatapointModel is the model of a datapoint of a Fabric metric
+type MetricDatapointModel struct {
+	StartDateTime types.String  `tfsdk:"start_date_time"`
+	EndDateTime   types.String  `tfsdk:"end_date_time"`
+	Value         types.Float32 `tfsdk:"value"`
+}
+
+// MetricDatapointsAttribute returns the data source schema of the datapoints
+// of a Fabric metric
+func MetricDatapointsAttribute(ctx context.Context) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: "Metric datapoints",
+		Computed:    true,
+		CustomType:  fwtypes.NewListNestedObjectTypeOf[MetricDatapointModel](ctx),
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"start_date_time": schema.StringAttribute{
+					Description: "Datapoint start date and time",
+					Computed:    true,
+				},
+				"end_date_time": schema.StringAttribute{
+					Description: "Datapoint end date and time",
+					Computed:    true,
+				},
+				"value": schema.Float32Attribute{
+					Description: "Datapoint value",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// ParseMetricDatapoints converts the datapoints of a Fabric metric to their model
+func ParseMetricDatapoints(ctx context.Context, metricDatapoints []fabricv4.MetricDatapoints) fwtypes.ListNestedObjectValueOf[MetricDatapointModel] {
+	datapoints := make([]MetricDatapointModel, len(metricDatapoints))
+	for i, datapoint := range metricDatapoints {
+		datapoints[i] = MetricDatapointModel{
+			StartDateTime: types.StringValue(FormatOptionalTime(datapoint.StartDateTime)),
+			EndDateTime:   types.StringValue(FormatOptionalTime(datapoint.EndDateTime)),
+			Value:         types.Float32Value(datapoint.GetValue()),
+		}
+	}
+	return fwtypes.NewListNestedObjectValueOfValueSlice[MetricDatapointModel](ctx, datapoints)
+}
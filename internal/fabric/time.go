@@ -0,0 +1,40 @@
+package fabric
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FormatOptionalTime formats the time in TimeFormat, or returns an empty
+// string when the time is not set
+func FormatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(TimeFormat)
+}
+
+// ParseTimeWindow parses the RFC 3339 start and end of a time window, the end
+// defaulting to the current time when not set
+func ParseTimeWindow(start, end types.String) (time.Time, time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	startDateTime, err := time.Parse(time.RFC3339, start.ValueString())
+	if err != nil {
+		diags.AddError("invalid start_date_time", err.Error())
+		return time.Time{}, time.Time{}, diags
+	}
+	endDateTime := time.Now().UTC()
+	if !end.IsNull() {
+		endDateTime, err = time.Parse(time.RFC3339, end.ValueString())
+		if err != nil {
+			diags.AddError("invalid end_date_time", err.Error())
+			return time.Time{}, time.Time{}, diags
+		}
+	}
+	if !endDateTime.After(startDateTime) {
+		diags.AddError("invalid time window", "end_date_time must be after start_date_time")
+	}
+	return startDateTime, endDateTime, diags
+}
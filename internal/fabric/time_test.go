@@ -0,0 +1,39 @@
+package fabric
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTimeWindow(t *testing.T) {
+	// given
+	start := types.StringValue("2025-01-01T00:00:00Z")
+	end := types.StringValue("2025-01-02T00:00:00Z")
+	// when
+	startDateTime, endDateTime, diags := ParseTimeWindow(start, end)
+	_, defaultEndDateTime, defaultEndDiags := ParseTimeWindow(start, types.StringNull())
+	_, _, invalidDiags := ParseTimeWindow(types.StringValue("yesterday"), end)
+	_, _, reversedDiags := ParseTimeWindow(end, start)
+	// then
+	assert.False(t, diags.HasError(), "Valid time window is parsed")
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), startDateTime.UTC())
+	assert.Equal(t, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), endDateTime.UTC())
+	assert.False(t, defaultEndDiags.HasError(), "Time window without end is parsed")
+	assert.WithinDuration(t, time.Now(), defaultEndDateTime, time.Minute, "End of time window defaults to now")
+	assert.Equal(t, "invalid start_date_time", invalidDiags[0].Summary(), "Start not in RFC 3339 format is rejected")
+	assert.Equal(t, "invalid time window", reversedDiags[0].Summary(), "End before start is rejected")
+}
+
+func TestFormatOptionalTime(t *testing.T) {
+	// given
+	value := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	// when
+	formatted := FormatOptionalTime(&value)
+	unset := FormatOptionalTime(nil)
+	// then
+	assert.Equal(t, "2025-01-01T12:00:00.000Z", formatted)
+	assert.Equal(t, "", unset, "Unset time is formatted as an empty string")
+}
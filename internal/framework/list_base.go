@@ -0,0 +1,162 @@
+package framework
+
+import (
+	"context"
+	"iter"
+
+	"github.com/equinix/terraform-provider-equinix/internal/config"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ListPageSize is the number of items requested per page by list resources
+const ListPageSize int32 = 100
+
+// NewBaseListResource returns a new instance of the BaseListResource
+// struct for cleaner initialization.
+func NewBaseListResource(cfg BaseListResourceConfig) BaseListResource {
+	return BaseListResource{
+		Config: cfg,
+	}
+}
+
+// BaseListResourceConfig contains all configurable base list resource fields.
+type BaseListResourceConfig struct {
+	// Name must match the name of the listed managed resource
+	Name string
+
+	// Optional
+	Schema *schema.Schema
+}
+
+// BaseListResource contains various re-usable fields and methods
+// intended for use in list resource implementations by composition.
+type BaseListResource struct {
+	Config BaseListResourceConfig
+	Meta   *config.Config
+}
+
+func (r *BaseListResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.Meta = GetResourceMeta(req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *BaseListResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = r.Config.Name
+}
+
+func (r *BaseListResource) ListResourceConfigSchema(
+	ctx context.Context,
+	req list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	if r.Config.Schema == nil {
+		resp.Diagnostics.AddError(
+			"Missing Schema",
+			"Base list resource was not provided a schema. "+
+				"Please provide a Schema config attribute or implement, the ListResourceConfigSchema(...) function.",
+		)
+		return
+	}
+
+	resp.Schema = *r.Config.Schema
+}
+
+// ListItem identifies a resource instance found by a list resource
+type ListItem struct {
+	ID          string
+	DisplayName string
+}
+
+// NewListResult returns the result of a listed item, identified by its uuid
+func NewListResult(ctx context.Context, req list.ListRequest, item ListItem) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = item.DisplayName
+	result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(identityUUIDAttr), item.ID)...)
+	return result
+}
+
+// ListResults streams the result built by toResult for each of the listed items,
+// up to the limit of the request. Items are only consumed as results are pulled,
+// so paginated items are not fetched past the limit. Listing stops at the first
+// error, which is streamed as the last result. Items for which toResult returns
+// false are skipped
+func ListResults[T any](
+	ctx context.Context,
+	req list.ListRequest,
+	items iter.Seq2[T, error],
+	toResult func(context.Context, T) (list.ListResult, bool),
+) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		var count int64
+		for item, err := range items {
+			if err != nil {
+				var diags diag.Diagnostics
+				diags.AddError("Failed listing resources", err.Error())
+				push(list.ListResult{Diagnostics: diags})
+				return
+			}
+			result, ok := toResult(ctx, item)
+			if !ok {
+				continue
+			}
+			if !push(result) {
+				return
+			}
+			count++
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+		}
+	}
+}
+
+// Paginate iterates over the items of the pages returned by fetch. A page is
+// only fetched once the items of the previous page have been consumed. fetch
+// returns the items of the page starting at offset and whether more follow
+func Paginate[T any](fetch func(offset int32) ([]T, bool, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var offset int32
+		for {
+			items, more, err := fetch(offset)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if !more || len(items) == 0 {
+				return
+			}
+			offset += int32(len(items))
+		}
+	}
+}
+
+// HasMorePages reports whether more items follow the page starting at offset
+// with count items, out of total items
+func HasMorePages(offset int32, count int, total int32) bool {
+	return offset+int32(count) < total
+}
@@ -0,0 +1,153 @@
+package framework
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testListRequest(limit int64) list.ListRequest {
+	return list.ListRequest{
+		Limit: limit,
+		ResourceSchema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"id":   schema.StringAttribute{Computed: true},
+				"name": schema.StringAttribute{Optional: true},
+			},
+		},
+		ResourceIdentitySchema: identityschema.Schema{
+			Attributes: map[string]identityschema.Attribute{
+				"uuid": identityschema.StringAttribute{RequiredForImport: true},
+			},
+		},
+	}
+}
+
+func testListItems(ids ...string) []ListItem {
+	items := make([]ListItem, len(ids))
+	for i, id := range ids {
+		items[i] = ListItem{ID: id, DisplayName: "name-" + id}
+	}
+	return items
+}
+
+func collectListResults(results func(func(list.ListResult) bool)) []list.ListResult {
+	var collected []list.ListResult
+	for result := range results {
+		collected = append(collected, result)
+	}
+	return collected
+}
+
+func TestPaginate(t *testing.T) {
+	// given
+	pages := [][]ListItem{testListItems("a", "b"), testListItems("c")}
+	var offsets []int32
+	fetch := func(offset int32) ([]ListItem, bool, error) {
+		offsets = append(offsets, offset)
+		page := pages[len(offsets)-1]
+		return page, HasMorePages(offset, len(page), 3), nil
+	}
+
+	// when
+	var ids []string
+	for item, err := range Paginate(fetch) {
+		require.NoError(t, err)
+		ids = append(ids, item.ID)
+	}
+
+	// then
+	assert.Equal(t, []string{"a", "b", "c"}, ids)
+	assert.Equal(t, []int32{0, 2}, offsets)
+}
+
+func TestPaginate_StopsFetchingWhenItemsAreNoLongerConsumed(t *testing.T) {
+	// given
+	fetches := 0
+	fetch := func(offset int32) ([]ListItem, bool, error) {
+		fetches++
+		return testListItems("a", "b"), true, nil
+	}
+
+	// when
+	for range Paginate(fetch) {
+		break
+	}
+
+	// then
+	assert.Equal(t, 1, fetches)
+}
+
+func TestListResults(t *testing.T) {
+	ctx := context.Background()
+	tests := map[string]struct {
+		limit    int64
+		items    []ListItem
+		err      error
+		expected []string
+	}{
+		"all items": {
+			items:    testListItems("a", "b", "c"),
+			expected: []string{"a", "b", "c"},
+		},
+		"limited items": {
+			limit:    2,
+			items:    testListItems("a", "b", "c"),
+			expected: []string{"a", "b"},
+		},
+		"skipped items do not count towards the limit": {
+			limit:    2,
+			items:    testListItems("a", "skipped", "b", "c"),
+			expected: []string{"a", "b"},
+		},
+		"error ends the results": {
+			items:    testListItems("a"),
+			err:      errors.New("search failed"),
+			expected: []string{"a", ""},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			req := testListRequest(tc.limit)
+			items := func(yield func(ListItem, error) bool) {
+				for _, item := range tc.items {
+					if !yield(item, nil) {
+						return
+					}
+				}
+				if tc.err != nil {
+					yield(ListItem{}, tc.err)
+				}
+			}
+			toResult := func(ctx context.Context, item ListItem) (list.ListResult, bool) {
+				return NewListResult(ctx, req, item), item.ID != "skipped"
+			}
+
+			// when
+			results := collectListResults(ListResults(ctx, req, items, toResult))
+
+			// then
+			ids := make([]string, len(results))
+			for i, result := range results {
+				if result.Identity != nil {
+					require.False(t, result.Identity.GetAttribute(ctx, path.Root(identityUUIDAttr), &ids[i]).HasError())
+					assert.Equal(t, "name-"+ids[i], result.DisplayName)
+				}
+			}
+			assert.Equal(t, tc.expected, ids)
+			if tc.err != nil {
+				last := results[len(results)-1]
+				require.True(t, last.Diagnostics.HasError())
+				assert.Equal(t, tc.err.Error(), last.Diagnostics.Errors()[0].Detail())
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package framework
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NewSDKv2ListResource returns a new instance of the SDKv2ListResource
+// struct for cleaner initialization.
+func NewSDKv2ListResource(cfg BaseListResourceConfig, r *sdkschema.Resource) SDKv2ListResource {
+	return SDKv2ListResource{
+		BaseListResource: NewBaseListResource(cfg),
+		Resource:         r,
+	}
+}
+
+// SDKv2ListResource is the base of list resources for managed resources that are
+// implemented with terraform-plugin-sdk/v2 and identified by their uuid. The
+// listed resources are read with the Read function of the SDKv2 resource
+type SDKv2ListResource struct {
+	BaseListResource
+	Resource *sdkschema.Resource
+}
+
+// RawV6Schemas returns the schema and identity schema of the SDKv2 resource,
+// which is not known to the framework provider
+func (r *SDKv2ListResource) RawV6Schemas(
+	ctx context.Context,
+	_ list.RawV6SchemaRequest,
+	resp *list.RawV6SchemaResponse,
+) {
+	resp.ProtoV6Schema = protoV6Schema(r.Resource.ProtoSchema(ctx)())
+	if identitySchema := r.Resource.ProtoIdentitySchema(ctx); identitySchema != nil {
+		resp.ProtoV6IdentitySchema = protoV6IdentitySchema(identitySchema())
+	}
+}
+
+// Results streams the results of the listed items. When the full resource is
+// requested, each item is read with the Read function of the SDKv2 resource
+// and items that no longer exist are skipped
+func (r *SDKv2ListResource) Results(
+	ctx context.Context,
+	req list.ListRequest,
+	items iter.Seq2[ListItem, error],
+) iter.Seq[list.ListResult] {
+	return ListResults(ctx, req, items, func(ctx context.Context, item ListItem) (list.ListResult, bool) {
+		result := NewListResult(ctx, req, item)
+		if !req.IncludeResource || result.Diagnostics.HasError() {
+			return result, true
+		}
+
+		d := r.Resource.Data(nil)
+		d.SetId(item.ID)
+		result.Diagnostics.Append(fromSDKv2Diagnostics(r.Resource.ReadContext(ctx, d, r.Meta))...)
+		if result.Diagnostics.HasError() {
+			return result, true
+		}
+		if d.Id() == "" {
+			return result, false
+		}
+
+		state, err := d.TfTypeResourceState()
+		if err != nil {
+			result.Diagnostics.AddError("Failed converting resource state", err.Error())
+			return result, true
+		}
+		result.Resource.Raw = *state
+		return result, true
+	})
+}
+
+func fromSDKv2Diagnostics(sdkDiags sdkdiag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, d := range sdkDiags {
+		if d.Severity == sdkdiag.Error {
+			diags.AddError(d.Summary, d.Detail)
+		} else {
+			diags.AddWarning(d.Summary, d.Detail)
+		}
+	}
+	return diags
+}
+
+// protoV6Schema converts the protocol version 5 schema of an SDKv2 resource to
+// protocol version 6. Version 6 only adds nested attribute types, which
+// SDKv2 schemas never use
+func protoV6Schema(s *tfprotov5.Schema) *tfprotov6.Schema {
+	if s == nil {
+		return nil
+	}
+	return &tfprotov6.Schema{
+		Version: s.Version,
+		Block:   protoV6SchemaBlock(s.Block),
+	}
+}
+
+func protoV6SchemaBlock(b *tfprotov5.SchemaBlock) *tfprotov6.SchemaBlock {
+	if b == nil {
+		return nil
+	}
+	block := &tfprotov6.SchemaBlock{
+		Version:         b.Version,
+		Description:     b.Description,
+		DescriptionKind: tfprotov6.StringKind(b.DescriptionKind),
+		Deprecated:      b.Deprecated,
+	}
+	for _, a := range b.Attributes {
+		block.Attributes = append(block.Attributes, &tfprotov6.SchemaAttribute{
+			Name:            a.Name,
+			Type:            a.Type,
+			Description:     a.Description,
+			Required:        a.Required,
+			Optional:        a.Optional,
+			Computed:        a.Computed,
+			Sensitive:       a.Sensitive,
+			DescriptionKind: tfprotov6.StringKind(a.DescriptionKind),
+			Deprecated:      a.Deprecated,
+			WriteOnly:       a.WriteOnly,
+		})
+	}
+	for _, nb := range b.BlockTypes {
+		block.BlockTypes = append(block.BlockTypes, &tfprotov6.SchemaNestedBlock{
+			TypeName: nb.TypeName,
+			Block:    protoV6SchemaBlock(nb.Block),
+			Nesting:  tfprotov6.SchemaNestedBlockNestingMode(nb.Nesting),
+			MinItems: nb.MinItems,
+			MaxItems: nb.MaxItems,
+		})
+	}
+	return block
+}
+
+func protoV6IdentitySchema(s *tfprotov5.ResourceIdentitySchema) *tfprotov6.ResourceIdentitySchema {
+	if s == nil {
+		return nil
+	}
+	identitySchema := &tfprotov6.ResourceIdentitySchema{
+		Version: s.Version,
+	}
+	for _, a := range s.IdentityAttributes {
+		identitySchema.IdentityAttributes = append(identitySchema.IdentityAttributes, &tfprotov6.ResourceIdentitySchemaAttribute{
+			Name:              a.Name,
+			Type:              a.Type,
+			RequiredForImport: a.RequiredForImport,
+			OptionalForImport: a.OptionalForImport,
+			Description:       a.Description,
+		})
+	}
+	return identitySchema
+}
@@ -0,0 +1,91 @@
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSDKv2ListResource() *SDKv2ListResource {
+	r := NewSDKv2ListResource(BaseListResourceConfig{Name: "equinix_test"}, &sdkschema.Resource{
+		Schema: map[string]*sdkschema.Schema{
+			"name": {Type: sdkschema.TypeString, Optional: true},
+		},
+		Identity: &sdkschema.ResourceIdentity{
+			SchemaFunc: func() map[string]*sdkschema.Schema {
+				return map[string]*sdkschema.Schema{
+					"uuid": {Type: sdkschema.TypeString, RequiredForImport: true},
+				}
+			},
+		},
+		ReadContext: func(_ context.Context, d *sdkschema.ResourceData, _ any) sdkdiag.Diagnostics {
+			if d.Id() == "deleted" {
+				d.SetId("")
+				return nil
+			}
+			return sdkdiag.FromErr(d.Set("name", "read-"+d.Id()))
+		},
+	})
+	return &r
+}
+
+func TestSDKv2ListResource_RawV6Schemas(t *testing.T) {
+	// given
+	r := testSDKv2ListResource()
+	resp := &list.RawV6SchemaResponse{}
+
+	// when
+	r.RawV6Schemas(context.Background(), list.RawV6SchemaRequest{}, resp)
+
+	// then
+	require.NotNil(t, resp.ProtoV6Schema)
+	attrs := map[string]*tfprotov6.SchemaAttribute{}
+	for _, a := range resp.ProtoV6Schema.Block.Attributes {
+		attrs[a.Name] = a
+	}
+	assert.Contains(t, attrs, "id")
+	require.Contains(t, attrs, "name")
+	assert.True(t, attrs["name"].Optional)
+	assert.Equal(t, tftypes.String, attrs["name"].Type)
+	require.NotNil(t, resp.ProtoV6IdentitySchema)
+	require.Len(t, resp.ProtoV6IdentitySchema.IdentityAttributes, 1)
+	assert.Equal(t, "uuid", resp.ProtoV6IdentitySchema.IdentityAttributes[0].Name)
+	assert.True(t, resp.ProtoV6IdentitySchema.IdentityAttributes[0].RequiredForImport)
+}
+
+func TestSDKv2ListResource_Results(t *testing.T) {
+	// given
+	ctx := context.Background()
+	r := testSDKv2ListResource()
+	req := testListRequest(0)
+	req.IncludeResource = true
+	items := func(yield func(ListItem, error) bool) {
+		for _, item := range testListItems("a", "deleted", "b") {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+
+	// when
+	results := collectListResults(r.Results(ctx, req, items))
+
+	// then
+	require.Len(t, results, 2, "deleted items are skipped")
+	for i, id := range []string{"a", "b"} {
+		require.False(t, results[i].Diagnostics.HasError(), results[i].Diagnostics)
+		var uuid, name string
+		require.False(t, results[i].Identity.GetAttribute(ctx, path.Root("uuid"), &uuid).HasError())
+		require.False(t, results[i].Resource.GetAttribute(ctx, path.Root("name"), &name).HasError())
+		assert.Equal(t, id, uuid)
+		assert.Equal(t, "read-"+id, name)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/equinix/terraform-provider-equinix/internal/config"
+	"github.com/equinix/terraform-provider-equinix/internal/importer"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -48,6 +49,10 @@ type BaseResourceConfig struct {
 
 	// Optional
 	Schema *schema.Schema
+	// ParentIDAttrs lists the attributes holding the IDs of parent resources,
+	// which are needed to read a nested resource. When set, resources are
+	// imported with a composite ID in the form of <parent ids...>/<id>
+	ParentIDAttrs []string
 }
 
 // BaseResource contains various re-usable fields and methods
@@ -122,19 +127,29 @@ func (r *BaseResource) ImportState(
 		return
 	}
 
-	// Handle type conversion
-	var err error
-	var idValue any = req.ID
+	// Import blocks may identify the resource by its identity instead of an ID
+	if req.ID == "" && req.Identity != nil {
+		r.importStateFromIdentity(ctx, req, resp)
+		return
+	}
 
+	if len(r.Config.ParentIDAttrs) == 0 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, req.ID)...)
+		return
+	}
+
+	parts, err := importer.ParseCompositeID(req.ID, append(append([]string{}, r.Config.ParentIDAttrs...), idAttr)...)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Failed to convert ID attribute",
+			"Unexpected Import Identifier",
 			err.Error(),
 		)
 		return
 	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, idValue)...)
+	for i, attr := range r.Config.ParentIDAttrs {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr), parts[i])...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, parts[len(parts)-1])...)
 }
 
 // WithTimeouts is intended to be embedded in resources which use the special "timeouts" nested block.
@@ -0,0 +1,94 @@
+package framework
+
+import (
+	"context"
+
+	"github.com/equinix/terraform-provider-equinix/internal/importer"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// identityUUIDAttr is the identity attribute holding the resource's own ID
+// for resources that are identified by their "id" attribute
+const identityUUIDAttr = importer.IdentityUUID
+
+// identityAttr maps a state attribute to the identity attribute holding its value
+type identityAttr struct {
+	state    string
+	identity string
+}
+
+// IdentitySchema returns the identity schema of the resource, made of the IDs
+// of its parent resources and its own UUID
+func (r *BaseResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	attributes := make(map[string]identityschema.Attribute)
+	for _, attr := range r.identityAttrs() {
+		attributes[attr.identity] = identityschema.StringAttribute{
+			RequiredForImport: true,
+		}
+	}
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// SetIdentity copies the resource and parent IDs from state into identity.
+// It should be called by Create, Read and Update once the state is set
+func (r *BaseResource) SetIdentity(
+	ctx context.Context,
+	state tfsdk.State,
+	identity *tfsdk.ResourceIdentity,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	// Identity is not available with Terraform versions that do not support it
+	if identity == nil {
+		return diags
+	}
+	for _, attr := range r.identityAttrs() {
+		var value types.String
+		diags.Append(state.GetAttribute(ctx, path.Root(attr.state), &value)...)
+		if diags.HasError() {
+			return diags
+		}
+		diags.Append(identity.SetAttribute(ctx, path.Root(attr.identity), value)...)
+	}
+	return diags
+}
+
+// importStateFromIdentity sets the resource and parent IDs in state from the
+// identity given in an import block
+func (r *BaseResource) importStateFromIdentity(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	for _, attr := range r.identityAttrs() {
+		var value types.String
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(attr.identity), &value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.state), value)...)
+	}
+}
+
+func (r *BaseResource) identityAttrs() []identityAttr {
+	attrs := make([]identityAttr, 0, len(r.Config.ParentIDAttrs)+1)
+	for _, attr := range r.Config.ParentIDAttrs {
+		attrs = append(attrs, identityAttr{state: attr, identity: attr})
+	}
+	idAttr := r.Config.IDAttr
+	if idAttr == "" || idAttr == "id" {
+		return append(attrs, identityAttr{state: "id", identity: identityUUIDAttr})
+	}
+	return append(attrs, identityAttr{state: idAttr, identity: idAttr})
+}
@@ -0,0 +1,133 @@
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testNestedResource() *BaseResource {
+	r := NewBaseResource(BaseResourceConfig{
+		Name:          "equinix_fabric_test",
+		ParentIDAttrs: []string{"stream_id"},
+	})
+	return &r
+}
+
+func testNestedResourceState(ctx context.Context, values map[string]tftypes.Value) tfsdk.State {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":        schema.StringAttribute{Computed: true},
+			"stream_id": schema.StringAttribute{Required: true},
+		},
+	}
+	objectType := s.Type().TerraformType(ctx)
+	raw := tftypes.NewValue(objectType, nil)
+	if values != nil {
+		raw = tftypes.NewValue(objectType, values)
+	}
+	return tfsdk.State{Schema: s, Raw: raw}
+}
+
+func testNestedResourceIdentity(r *BaseResource, values map[string]tftypes.Value) *tfsdk.ResourceIdentity {
+	ctx := context.Background()
+	schemaResp := &resource.IdentitySchemaResponse{}
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, schemaResp)
+	objectType := schemaResp.IdentitySchema.Type().TerraformType(ctx)
+	raw := tftypes.NewValue(objectType, nil)
+	if values != nil {
+		raw = tftypes.NewValue(objectType, values)
+	}
+	return &tfsdk.ResourceIdentity{Schema: schemaResp.IdentitySchema, Raw: raw}
+}
+
+func TestBaseResource_IdentitySchema(t *testing.T) {
+	// given
+	r := testNestedResource()
+	resp := &resource.IdentitySchemaResponse{}
+
+	// when
+	r.IdentitySchema(context.Background(), resource.IdentitySchemaRequest{}, resp)
+
+	// then
+	assert.Len(t, resp.IdentitySchema.Attributes, 2)
+	assert.Contains(t, resp.IdentitySchema.Attributes, "stream_id")
+	assert.Contains(t, resp.IdentitySchema.Attributes, "uuid")
+	assert.True(t, resp.IdentitySchema.Attributes["uuid"].IsRequiredForImport())
+}
+
+func TestBaseResource_SetIdentity(t *testing.T) {
+	// given
+	ctx := context.Background()
+	r := testNestedResource()
+	state := testNestedResourceState(ctx, map[string]tftypes.Value{
+		"id":        tftypes.NewValue(tftypes.String, "subscription-uuid"),
+		"stream_id": tftypes.NewValue(tftypes.String, "stream-uuid"),
+	})
+	identity := testNestedResourceIdentity(r, nil)
+
+	// when
+	diags := r.SetIdentity(ctx, state, identity)
+
+	// then
+	require.False(t, diags.HasError(), diags)
+	var uuid, streamID string
+	require.False(t, identity.GetAttribute(ctx, path.Root("uuid"), &uuid).HasError())
+	require.False(t, identity.GetAttribute(ctx, path.Root("stream_id"), &streamID).HasError())
+	assert.Equal(t, "subscription-uuid", uuid)
+	assert.Equal(t, "stream-uuid", streamID)
+	assert.False(t, r.SetIdentity(ctx, state, nil).HasError(), "missing identity is ignored")
+}
+
+func TestBaseResource_ImportState(t *testing.T) {
+	ctx := context.Background()
+	r := testNestedResource()
+	tests := map[string]struct {
+		id       string
+		identity *tfsdk.ResourceIdentity
+		err      bool
+	}{
+		"composite ID": {
+			id: "stream-uuid/subscription-uuid",
+		},
+		"identity": {
+			identity: testNestedResourceIdentity(r, map[string]tftypes.Value{
+				"uuid":      tftypes.NewValue(tftypes.String, "subscription-uuid"),
+				"stream_id": tftypes.NewValue(tftypes.String, "stream-uuid"),
+			}),
+		},
+		"bare ID": {
+			id:  "subscription-uuid",
+			err: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			req := resource.ImportStateRequest{ID: tc.id, Identity: tc.identity}
+			resp := &resource.ImportStateResponse{State: testNestedResourceState(ctx, nil)}
+
+			// when
+			r.ImportState(ctx, req, resp)
+
+			// then
+			if tc.err {
+				assert.True(t, resp.Diagnostics.HasError())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			var id, streamID string
+			require.False(t, resp.State.GetAttribute(ctx, path.Root("id"), &id).HasError())
+			require.False(t, resp.State.GetAttribute(ctx, path.Root("stream_id"), &streamID).HasError())
+			assert.Equal(t, "subscription-uuid", id)
+			assert.Equal(t, "stream-uuid", streamID)
+		})
+	}
+}
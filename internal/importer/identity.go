@@ -0,0 +1,121 @@
+package importer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// IdentityUUID is the identity attribute of resources identified by their UUID alone
+const IdentityUUID = "uuid"
+
+// UUIDIdentity returns the identity schema of SDKv2 resources that are
+// identified by their UUID alone
+func UUIDIdentity() *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				IdentityUUID: {
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+					Description:       "Unique identifier of the resource",
+				},
+			}
+		},
+	}
+}
+
+// UUIDImporter imports SDKv2 resources either by ID or by the uuid attribute
+// of their identity
+func UUIDImporter() *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: schema.ImportStatePassthroughWithIdentity(IdentityUUID),
+	}
+}
+
+// SetUUIDIdentity sets the resource identity from the resource ID
+func SetUUIDIdentity(d *schema.ResourceData) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return fmt.Errorf("error reading resource identity: %s", err)
+	}
+	if err := identity.Set(IdentityUUID, d.Id()); err != nil {
+		return fmt.Errorf("error setting resource identity: %s", err)
+	}
+	return nil
+}
+
+// CompositeIdentity returns the identity schema of SDKv2 resources that are
+// identified by the values of several attributes, given as a map of attribute
+// names to descriptions
+func CompositeIdentity(descriptions map[string]string) *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			identitySchema := make(map[string]*schema.Schema, len(descriptions))
+			for attr, description := range descriptions {
+				identitySchema[attr] = &schema.Schema{
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+					Description:       description,
+				}
+			}
+			return identitySchema
+		},
+	}
+}
+
+// CompositeImporter imports SDKv2 resources identified by the values of attrs,
+// either by an attrs[0]/.../attrs[n] composite ID or by the attributes of
+// their identity. Each part is set on the corresponding attribute and the
+// resource ID is the composite ID
+func CompositeImporter(attrs ...string) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+			parts, err := compositeImportParts(d, attrs)
+			if err != nil {
+				return nil, err
+			}
+			for i, attr := range attrs {
+				if err := d.Set(attr, parts[i]); err != nil {
+					return nil, fmt.Errorf("error setting %s: %s", attr, err)
+				}
+			}
+			d.SetId(CompositeID(parts...))
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+func compositeImportParts(d *schema.ResourceData, attrs []string) ([]string, error) {
+	if d.Id() != "" {
+		return ParseCompositeID(d.Id(), attrs...)
+	}
+	identity, err := d.Identity()
+	if err != nil {
+		return nil, fmt.Errorf("error reading resource identity: %s", err)
+	}
+	parts := make([]string, len(attrs))
+	for i, attr := range attrs {
+		value, ok := identity.Get(attr).(string)
+		if !ok || value == "" {
+			return nil, fmt.Errorf("expected identity to contain key %s", attr)
+		}
+		parts[i] = value
+	}
+	return parts, nil
+}
+
+// SetCompositeIdentity sets the resource identity from the values of attrs
+func SetCompositeIdentity(d *schema.ResourceData, attrs ...string) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return fmt.Errorf("error reading resource identity: %s", err)
+	}
+	for _, attr := range attrs {
+		if err := identity.Set(attr, d.Get(attr)); err != nil {
+			return fmt.Errorf("error setting resource identity: %s", err)
+		}
+	}
+	return nil
+}
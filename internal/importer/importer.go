@@ -0,0 +1,63 @@
+// Package importer provides helpers for importing resources, either by their
+// resource identity or, for resources that can only be read together with the
+// ID of their parent resource, by composite import IDs in the form of parent/child
+package importer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Separator separates the parts of a composite import ID
+const Separator = "/"
+
+// CompositeID joins parts into a composite import ID
+func CompositeID(parts ...string) string {
+	return strings.Join(parts, Separator)
+}
+
+// ParseCompositeID splits a composite import ID into exactly one non-empty
+// part per given attribute name. Attribute names are only used to describe
+// the expected format in the returned error
+func ParseCompositeID(id string, attrs ...string) ([]string, error) {
+	parts := strings.Split(id, Separator)
+	if len(parts) != len(attrs) || len(attrs) == 0 {
+		return nil, formatError(id, attrs)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return nil, formatError(id, attrs)
+		}
+	}
+	return parts, nil
+}
+
+// StateContext returns an SDKv2 importer function for resources imported with
+// a parentAttrs[0]/.../parentAttrs[n]/id composite ID. Each leading part is set
+// on the corresponding parent attribute and the last part becomes the resource ID
+func StateContext(parentAttrs ...string) schema.StateContextFunc {
+	return func(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+		parts, err := ParseCompositeID(d.Id(), append(append([]string{}, parentAttrs...), "id")...)
+		if err != nil {
+			return nil, err
+		}
+		for i, attr := range parentAttrs {
+			if err := d.Set(attr, parts[i]); err != nil {
+				return nil, fmt.Errorf("error setting %s: %s", attr, err)
+			}
+		}
+		d.SetId(parts[len(parts)-1])
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+func formatError(id string, attrs []string) error {
+	expected := make([]string, len(attrs))
+	for i, attr := range attrs {
+		expected[i] = "<" + attr + ">"
+	}
+	return fmt.Errorf("unexpected format of ID (%s), expected %s", id, CompositeID(expected...))
+}
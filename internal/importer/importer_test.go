@@ -0,0 +1,159 @@
+package importer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCompositeID(t *testing.T) {
+	tests := map[string]struct {
+		id       string
+		attrs    []string
+		expected []string
+		err      string
+	}{
+		"parent and child": {
+			id:       "parent-uuid/child-uuid",
+			attrs:    []string{"route_filter_id", "id"},
+			expected: []string{"parent-uuid", "child-uuid"},
+		},
+		"multiple parents": {
+			id:       "stream-uuid/ports/port-uuid",
+			attrs:    []string{"stream_id", "asset", "asset_id"},
+			expected: []string{"stream-uuid", "ports", "port-uuid"},
+		},
+		"bare child ID": {
+			id:    "child-uuid",
+			attrs: []string{"route_filter_id", "id"},
+			err:   "unexpected format of ID (child-uuid), expected <route_filter_id>/<id>",
+		},
+		"too many parts": {
+			id:    "a/b/c",
+			attrs: []string{"connection_id", "id"},
+			err:   "unexpected format of ID (a/b/c), expected <connection_id>/<id>",
+		},
+		"empty part": {
+			id:    "/child-uuid",
+			attrs: []string{"stream_id", "id"},
+			err:   "unexpected format of ID (/child-uuid), expected <stream_id>/<id>",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// when
+			parts, err := ParseCompositeID(tc.id, tc.attrs...)
+
+			// then
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, parts)
+			assert.Equal(t, tc.id, CompositeID(parts...))
+		})
+	}
+}
+
+func TestStateContext(t *testing.T) {
+	// given
+	resourceSchema := map[string]*schema.Schema{
+		"route_filter_id": {Type: schema.TypeString, Required: true},
+	}
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]any{})
+	d.SetId("route-filter-uuid/rule-uuid")
+
+	// when
+	imported, err := StateContext("route_filter_id")(context.Background(), d, nil)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, imported, 1)
+	assert.Equal(t, "rule-uuid", imported[0].Id())
+	assert.Equal(t, "route-filter-uuid", imported[0].Get("route_filter_id"))
+}
+
+func TestStateContext_invalidID(t *testing.T) {
+	// given
+	resourceSchema := map[string]*schema.Schema{
+		"connection_id": {Type: schema.TypeString, Required: true},
+	}
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]any{})
+	d.SetId("route-filter-uuid")
+
+	// when
+	_, err := StateContext("connection_id")(context.Background(), d, nil)
+
+	// then
+	assert.EqualError(t, err, "unexpected format of ID (route-filter-uuid), expected <connection_id>/<id>")
+}
+
+func testCompositeResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"user_id":   {Type: schema.TypeString, Required: true},
+			"device_id": {Type: schema.TypeString, Required: true},
+		},
+		Identity: CompositeIdentity(map[string]string{
+			"user_id":   "Unique identifier of the user",
+			"device_id": "Unique identifier of the device",
+		}),
+		Importer: CompositeImporter("user_id", "device_id"),
+	}
+}
+
+func TestCompositeImporter(t *testing.T) {
+	// given
+	r := testCompositeResource()
+	d := r.TestResourceData()
+	d.SetId("user-uuid/device-uuid")
+
+	// when
+	imported, err := r.Importer.StateContext(context.Background(), d, nil)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, imported, 1)
+	assert.Equal(t, "user-uuid/device-uuid", imported[0].Id())
+	assert.Equal(t, "user-uuid", imported[0].Get("user_id"))
+	assert.Equal(t, "device-uuid", imported[0].Get("device_id"))
+}
+
+func TestCompositeImporter_identity(t *testing.T) {
+	// given
+	r := testCompositeResource()
+	d := r.TestResourceData()
+	identity, err := d.Identity()
+	require.NoError(t, err)
+	require.NoError(t, identity.Set("user_id", "user-uuid"))
+	require.NoError(t, identity.Set("device_id", "device-uuid"))
+
+	// when
+	imported, err := r.Importer.StateContext(context.Background(), d, nil)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "user-uuid/device-uuid", imported[0].Id(), "Resource ID is composed from identity")
+	assert.Equal(t, "device-uuid", imported[0].Get("device_id"))
+}
+
+func TestSetCompositeIdentity(t *testing.T) {
+	// given
+	d := testCompositeResource().TestResourceData()
+	require.NoError(t, d.Set("user_id", "user-uuid"))
+	require.NoError(t, d.Set("device_id", "device-uuid"))
+
+	// when
+	err := SetCompositeIdentity(d, "user_id", "device_id")
+
+	// then
+	require.NoError(t, err)
+	identity, err := d.Identity()
+	require.NoError(t, err)
+	assert.Equal(t, "user-uuid", identity.Get("user_id"))
+	assert.Equal(t, "device-uuid", identity.Get("device_id"))
+}
@@ -0,0 +1,29 @@
+package network
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/equinix/ne-go"
+	"github.com/equinix/rest-go"
+)
+
+// Client provides Network Edge operations that are not yet available in ne-go.
+// It reuses the authenticated REST client behind the provider's ne.Client so
+// requests share the provider's transport, user agent and page size.
+type Client struct {
+	*rest.Client
+}
+
+// NewClient returns a Client backed by the REST client of neClient
+func NewClient(neClient ne.Client) (*Client, error) {
+	rc, ok := neClient.(*ne.RestClient)
+	if !ok || rc == nil || rc.Client == nil {
+		return nil, fmt.Errorf("network edge client of type %T does not support extended device operations", neClient)
+	}
+	return &Client{rc.Client}, nil
+}
+
+func joinQueryParamValues(values []string) string {
+	return strings.Join(values, ",")
+}
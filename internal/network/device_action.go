@@ -0,0 +1,39 @@
+package network
+
+import (
+	"net/http"
+	"net/url"
+)
+
+const (
+	// DeviceStateRebooting Network Edge device is rebooting
+	DeviceStateRebooting = "REBOOTING"
+
+	// DeviceActionReboot gracefully reboots a device
+	DeviceActionReboot = "REBOOT"
+	// DeviceActionPowerCycle powers a device off and on again
+	DeviceActionPowerCycle = "POWER_CYCLE"
+	// DeviceActionRestore restores device configuration from a backup, see RestoreDeviceBackup
+	DeviceActionRestore = "RESTORE"
+)
+
+// DeviceActions lists lifecycle actions that can be performed on a device
+var DeviceActions = []string{DeviceActionReboot, DeviceActionPowerCycle, DeviceActionRestore}
+
+var deviceActionRebootTypes = map[string]string{
+	DeviceActionReboot:     "SOFT",
+	DeviceActionPowerCycle: "HARD",
+}
+
+type deviceRebootRequest struct {
+	RebootType *string `json:"rebootType"`
+}
+
+// TriggerDeviceAction triggers a reboot action, either DeviceActionReboot or
+// DeviceActionPowerCycle, on the device with a given uuid
+func (c *Client) TriggerDeviceAction(uuid string, action string) error {
+	rebootType := deviceActionRebootTypes[action]
+	path := "/ne/v1/devices/" + url.PathEscape(uuid) + "/reboots"
+	req := c.R().SetBody(&deviceRebootRequest{RebootType: &rebootType})
+	return c.Execute(req, http.MethodPost, path)
+}
@@ -0,0 +1,121 @@
+package network
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/equinix/rest-go"
+)
+
+const (
+	// DeviceBackupStatusPending backup is being created
+	DeviceBackupStatusPending = "PENDING"
+	// DeviceBackupStatusCompleted backup was created and can be restored
+	DeviceBackupStatusCompleted = "COMPLETED"
+	// DeviceBackupStatusFailed backup creation has failed
+	DeviceBackupStatusFailed = "FAILED"
+	// DeviceBackupStatusDeleted backup was deleted
+	DeviceBackupStatusDeleted = "DELETED"
+
+	// DeviceStateRestoreInProgress Network Edge device configuration is being restored from a backup
+	DeviceStateRestoreInProgress = "RESTORE_IN_PROGRESS"
+)
+
+// DeviceBackup describes Network Edge device configuration backup
+type DeviceBackup struct {
+	UUID            *string `json:"uuid,omitempty"`
+	Name            *string `json:"name,omitempty"`
+	DeviceUUID      *string `json:"deviceUuid,omitempty"`
+	Version         *string `json:"version,omitempty"`
+	Type            *string `json:"type,omitempty"`
+	Status          *string `json:"status,omitempty"`
+	CreatedBy       *string `json:"createdBy,omitempty"`
+	CreatedDate     *string `json:"createdDate,omitempty"`
+	LastUpdatedDate *string `json:"lastUpdatedDate,omitempty"`
+	DownloadURL     *string `json:"downloadUrl,omitempty"`
+	DeleteAllowed   *bool   `json:"deleteAllowed,omitempty"`
+}
+
+type deviceBackupCreateRequest struct {
+	DeviceUUID *string `json:"deviceUuid"`
+	Name       *string `json:"name"`
+}
+
+type deviceBackupCreateResponse struct {
+	UUID *string `json:"uuid,omitempty"`
+}
+
+type deviceBackupsResponse struct {
+	Pagination pagination     `json:"pagination,omitempty"`
+	Data       []DeviceBackup `json:"data,omitempty"`
+}
+
+type deviceRestoreRequest struct {
+	BackupUUID *string `json:"backupUuid"`
+}
+
+// CreateDeviceBackup creates a named configuration backup of the device with
+// a given uuid and returns the backup identifier
+func (c *Client) CreateDeviceBackup(deviceUUID string, name string) (*string, error) {
+	path := "/ne/v1/deviceBackups"
+	respBody := deviceBackupCreateResponse{}
+	req := c.R().
+		SetBody(&deviceBackupCreateRequest{DeviceUUID: &deviceUUID, Name: &name}).
+		SetResult(&respBody)
+	if err := c.Execute(req, http.MethodPost, path); err != nil {
+		return nil, err
+	}
+	return respBody.UUID, nil
+}
+
+// GetDeviceBackup retrieves the device backup with a given uuid
+func (c *Client) GetDeviceBackup(uuid string) (*DeviceBackup, error) {
+	path := "/ne/v1/deviceBackups/" + url.PathEscape(uuid)
+	result := DeviceBackup{}
+	req := c.R().SetResult(&result)
+	if err := c.Execute(req, http.MethodGet, path); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetDeviceBackups retrieves backups of the device with a given uuid,
+// optionally limited to backups in given statuses
+func (c *Client) GetDeviceBackups(deviceUUID string, statuses []string) ([]DeviceBackup, error) {
+	path := "/ne/v1/deviceBackups"
+	params := map[string]string{"virtualDeviceUuid": deviceUUID}
+	if len(statuses) > 0 {
+		params["status"] = joinQueryParamValues(statuses)
+	}
+	content, err := c.GetOffsetPaginated(path, &deviceBackupsResponse{},
+		rest.DefaultOffsetPagingConfig().SetAdditionalParams(params))
+	if err != nil {
+		return nil, err
+	}
+	backups := make([]DeviceBackup, len(content))
+	for i := range content {
+		backups[i] = content[i].(DeviceBackup)
+	}
+	return backups, nil
+}
+
+// UpdateDeviceBackupName renames the device backup with a given uuid
+func (c *Client) UpdateDeviceBackupName(uuid string, name string) error {
+	path := "/ne/v1/deviceBackups/" + url.PathEscape(uuid)
+	req := c.R().SetBody(map[string]string{"name": name})
+	return c.Execute(req, http.MethodPatch, path)
+}
+
+// DeleteDeviceBackup deletes the device backup with a given uuid
+func (c *Client) DeleteDeviceBackup(uuid string) error {
+	path := "/ne/v1/deviceBackups/" + url.PathEscape(uuid)
+	return c.Execute(c.R(), http.MethodDelete, path)
+}
+
+// RestoreDeviceBackup restores configuration of the device with a given uuid
+// from the backup with a given backupUUID
+func (c *Client) RestoreDeviceBackup(deviceUUID string, backupUUID string) error {
+	path := "/ne/v1/devices/" + url.PathEscape(deviceUUID) + "/restore"
+	req := c.R().SetBody(&deviceRestoreRequest{BackupUUID: &backupUUID})
+	return c.Execute(req, http.MethodPatch, path)
+}
@@ -0,0 +1,25 @@
+package network
+
+import (
+	"net/http"
+	"net/url"
+)
+
+const (
+	// DeviceStateSoftwareUpgradeInProgress Network Edge device is in process of software upgrade
+	DeviceStateSoftwareUpgradeInProgress = "SOFTWARE_UPGRADE_IN_PROGRESS"
+	// DeviceStateSoftwareUpgradeFailed Network Edge device software upgrade has failed
+	DeviceStateSoftwareUpgradeFailed = "SOFTWARE_UPGRADE_FAILED"
+)
+
+type deviceSoftwareUpgradeRequest struct {
+	Version *string `json:"version"`
+}
+
+// UpgradeDeviceSoftware requests an in-place software version upgrade of the
+// device with a given uuid
+func (c *Client) UpgradeDeviceSoftware(uuid string, version string) error {
+	path := "/ne/v1/devices/" + url.PathEscape(uuid) + "/softwareUpgrade"
+	req := c.R().SetBody(&deviceSoftwareUpgradeRequest{Version: &version})
+	return c.Execute(req, http.MethodPost, path)
+}
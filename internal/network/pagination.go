@@ -0,0 +1,8 @@
+package network
+
+// pagination describes pagination controls of Network Edge list responses
+type pagination struct {
+	Offset int `json:"offset,omitempty"`
+	Limit  int `json:"limit,omitempty"`
+	Total  int `json:"total,omitempty"`
+}
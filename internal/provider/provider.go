@@ -11,6 +11,7 @@ import (
 	equinix_validation "github.com/equinix/terraform-provider-equinix/internal/validation"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -22,12 +23,18 @@ import (
 type FrameworkProvider struct {
 	ProviderVersion string
 	Meta            *config.Config
+
+	// sdkv2ListResources lists the resources of the SDKv2 provider, which
+	// cannot be registered by the services of this package
+	sdkv2ListResources []func() list.ListResource
 }
 
 // CreateFrameworkProvider initializes a new FrameworkProvider with the specified version.
-func CreateFrameworkProvider(version string) provider.ProviderWithMetaSchema {
+// List resources of the resources implemented by the SDKv2 provider are given by sdkv2ListResources.
+func CreateFrameworkProvider(version string, sdkv2ListResources ...func() list.ListResource) provider.ProviderWithMetaSchema {
 	return &FrameworkProvider{
-		ProviderVersion: version,
+		ProviderVersion:    version,
+		sdkv2ListResources: sdkv2ListResources,
 	}
 }
 
@@ -100,6 +107,18 @@ func (p *FrameworkProvider) Schema(
 				Optional:    true,
 				Description: fmt.Sprintf("The name of the environment variable containing the subject token for token exchange. This argument can also be specified with the `EQUINIX_TOKEN_EXCHANGE_SUBJECT_TOKEN_ENV_VAR` shell environment variable. (Defaults to `%s`). Please note that token exchange is an alpha feature and not available for all users.", config.DefaultTokenExchangeSubjectTokenEnvVar),
 			},
+			"token_exchange_subject_token_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a file containing the subject token for token exchange, such as a Kubernetes projected service account token. The file is re-read whenever a new token exchange is needed, so rotated tokens are picked up during long applies. Takes precedence over `token_exchange_github_actions_oidc` and `token_exchange_subject_token_env_var`. This argument can also be specified with the `EQUINIX_TOKEN_EXCHANGE_SUBJECT_TOKEN_FILE` shell environment variable. Please note that token exchange is an alpha feature and not available for all users.",
+			},
+			"token_exchange_github_actions_oidc": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Request the subject token for token exchange from the GitHub Actions OIDC provider using the `ACTIONS_ID_TOKEN_REQUEST_URL` and `ACTIONS_ID_TOKEN_REQUEST_TOKEN` environment variables. A fresh token is requested whenever a new token exchange is needed. Takes precedence over `token_exchange_subject_token_env_var`. This argument can also be specified with the `EQUINIX_TOKEN_EXCHANGE_GITHUB_ACTIONS_OIDC` shell environment variable. Please note that token exchange is an alpha feature and not available for all users.",
+			},
+			"token_exchange_github_actions_audience": schema.StringAttribute{
+				Optional:    true,
+				Description: "The audience to request when `token_exchange_github_actions_oidc` is enabled. This argument can also be specified with the `EQUINIX_TOKEN_EXCHANGE_GITHUB_ACTIONS_AUDIENCE` shell environment variable. Please note that token exchange is an alpha feature and not available for all users.",
+			},
 			"max_retries": schema.Int64Attribute{
 				Optional:    true,
 				Description: "Maximum number of retries in case of network failure.",
@@ -144,3 +163,12 @@ func (p *FrameworkProvider) DataSources(_ context.Context) []func() datasource.D
 
 	return datasources
 }
+
+// ListResources returns a list of list resource constructors that the provider supports.
+func (p *FrameworkProvider) ListResources(_ context.Context) []func() list.ListResource {
+	listResources := []func() list.ListResource{}
+	listResources = append(listResources, services.FabricListResources()...)
+	listResources = append(listResources, p.sdkv2ListResources...)
+
+	return listResources
+}
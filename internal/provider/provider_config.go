@@ -28,6 +28,9 @@ type FrameworkProviderConfig struct {
 	StsBaseURL                      types.String `tfsdk:"sts_endpoint"`
 	TokenExchangeSubjectToken       types.String `tfsdk:"token_exchange_subject_token"`
 	TokenExchangeSubjectTokenEnvVar types.String `tfsdk:"token_exchange_subject_token_env_var"`
+	TokenExchangeSubjectTokenFile   types.String `tfsdk:"token_exchange_subject_token_file"`
+	TokenExchangeGitHubActionsOIDC  types.Bool   `tfsdk:"token_exchange_github_actions_oidc"`
+	TokenExchangeGitHubAudience     types.String `tfsdk:"token_exchange_github_actions_audience"`
 }
 
 func (c *FrameworkProviderConfig) toOldStyleConfig() *config.Config {
@@ -45,6 +48,9 @@ func (c *FrameworkProviderConfig) toOldStyleConfig() *config.Config {
 		StsBaseURL:                      c.StsBaseURL.ValueString(),
 		TokenExchangeSubjectToken:       c.TokenExchangeSubjectToken.ValueString(),
 		TokenExchangeSubjectTokenEnvVar: c.TokenExchangeSubjectTokenEnvVar.ValueString(),
+		TokenExchangeSubjectTokenFile:   c.TokenExchangeSubjectTokenFile.ValueString(),
+		TokenExchangeGitHubActionsOIDC:  c.TokenExchangeGitHubActionsOIDC.ValueBool(),
+		TokenExchangeGitHubAudience:     c.TokenExchangeGitHubAudience.ValueString(),
 	}
 }
 
@@ -102,6 +108,15 @@ func (fp *FrameworkProvider) Configure(
 	fwconfig.TokenExchangeSubjectTokenEnvVar = determineStrConfValue(
 		fwconfig.TokenExchangeSubjectTokenEnvVar, config.TokenExchangeSubjectTokenEnvVarEnvVar, config.DefaultTokenExchangeSubjectTokenEnvVar)
 
+	fwconfig.TokenExchangeSubjectTokenFile = determineStrConfValue(
+		fwconfig.TokenExchangeSubjectTokenFile, config.TokenExchangeSubjectTokenFileEnvVar, "")
+
+	fwconfig.TokenExchangeGitHubActionsOIDC = determineBoolConfValue(
+		fwconfig.TokenExchangeGitHubActionsOIDC, config.TokenExchangeGitHubActionsOIDCEnvVar, false, &resp.Diagnostics)
+
+	fwconfig.TokenExchangeGitHubAudience = determineStrConfValue(
+		fwconfig.TokenExchangeGitHubAudience, config.TokenExchangeGitHubAudienceEnvVar, "")
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -117,6 +132,7 @@ func (fp *FrameworkProvider) Configure(
 	}
 	resp.ResourceData = oldStyleConfig
 	resp.DataSourceData = oldStyleConfig
+	resp.ListResourceData = oldStyleConfig
 
 	fp.Meta = oldStyleConfig
 }
@@ -159,6 +175,31 @@ func determineIntConfValue(v basetypes.Int64Value, envVar string, defaultValue i
 	return types.Int64Value(GetIntFromEnv(envVar, defaultValue, diags))
 }
 
+func determineBoolConfValue(v basetypes.BoolValue, envVar string, defaultValue bool, diags *diag.Diagnostics) basetypes.BoolValue {
+	if !v.IsNull() {
+		return v
+	}
+	envVarVal := os.Getenv(envVar)
+	if envVarVal == "" {
+		return types.BoolValue(defaultValue)
+	}
+
+	boolVal, err := strconv.ParseBool(envVarVal)
+	if err != nil {
+		diags.AddWarning(
+			fmt.Sprintf(
+				"Failed to parse the environment variable %v "+
+					"to a boolean. Will use default value: %t instead",
+				envVar,
+				defaultValue,
+			),
+			err.Error(),
+		)
+		return types.BoolValue(defaultValue)
+	}
+	return types.BoolValue(boolVal)
+}
+
 func determineStrConfValue(v basetypes.StringValue, envVar, defaultValue string) basetypes.StringValue {
 	if !v.IsNull() {
 		return v
@@ -3,19 +3,25 @@ package services
 
 import (
 	advertisedRoutes "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/advertised_route"
+	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/connection"
 	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/connectionrouteaggregation"
+	internetaccess "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/internet_access"
 	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/metro"
+	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/observability"
 	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/port"
 	precisiontime "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/precision_time"
 	receivedRoutes "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/received_route"
+	fabric_route_filter "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/route_filter"
 	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/routeaggregation"
 	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/routeaggregationrule"
+	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/servicetoken"
 	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/stream"
 	streamattachment "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/stream_attachment"
 	streamsubscription "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/stream_subscription"
 	streamalertrule "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/streamalertrule"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -23,12 +29,14 @@ import (
 func FabricResources() []func() resource.Resource {
 	return []func() resource.Resource{
 		connectionrouteaggregation.NewResource,
+		internetaccess.NewResource,
 		port.NewResource,
 		precisiontime.NewResource,
 		routeaggregation.NewResource,
 		routeaggregationrule.NewResource,
 		stream.NewResource,
 		streamattachment.NewResource,
+		streamattachment.NewBulkResource,
 		streamsubscription.NewResource,
 		streamalertrule.NewResource,
 	}
@@ -39,10 +47,18 @@ func FabricDatasources() []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		connectionrouteaggregation.NewDataSourceByConnectionRouteAggregationID,
 		connectionrouteaggregation.NewDataSourceAllConnectionRouteAggregations,
+		internetaccess.NewDataSourceByServiceID,
+		internetaccess.NewDataSourceAllServices,
 		metro.NewDataSourceMetroCode,
 		metro.NewDataSourceMetros,
+		observability.NewDataSourceMetrics,
+		observability.NewDataSourceStreamEvents,
+		port.NewDataSourcePortPackages,
+		port.NewDataSourcePortStatistics,
 		precisiontime.NewDataSourceByEptServiceID,
 		precisiontime.NewDataSourceAllEptServices,
+		precisiontime.NewDataSourcePackages,
+		precisiontime.NewDataSourceStatus,
 		routeaggregation.NewDataSourceByRouteAggregationID,
 		routeaggregation.NewDataSourceAllRouteAggregation,
 		routeaggregationrule.NewDataSourceByRouteAggregationRuleID,
@@ -55,7 +71,18 @@ func FabricDatasources() []func() datasource.DataSource {
 		streamsubscription.NewDataSourceByIDs,
 		streamalertrule.NewDataSourceAllStreamAlertRules,
 		streamalertrule.NewDataSourceByStreamAlertRuleIDs,
+		streamalertrule.NewDataSourceAlertRuleTemplates,
 		advertisedRoutes.NewDataSourceAdvertisedRoutes,
 		receivedRoutes.NewDataSourceReceivedRoutes,
 	}
 }
+
+// FabricListResources represents fabric list resources
+func FabricListResources() []func() list.ListResource {
+	return []func() list.ListResource{
+		connection.NewListResource,
+		fabric_route_filter.NewListResource,
+		servicetoken.NewListResource,
+		stream.NewListResource,
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 func readFabricConnectionResourceSchema() map[string]*schema.Schema {
 	sch := fabricConnectionResourceSchema()
+	delete(sch, "bandwidth_schedule")
 	for key := range sch {
 		if key == "uuid" {
 			sch[key].Required = true
@@ -0,0 +1,152 @@
+package connection
+
+import (
+	"context"
+	"fmt"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listStates are the connection states listed when no states are given,
+// which leave out deprovisioned and cancelled connections
+var listStates = []string{
+	string(fabricv4.CONNECTIONSTATE_ACTIVE),
+	string(fabricv4.CONNECTIONSTATE_DEPROVISIONING),
+	string(fabricv4.CONNECTIONSTATE_DRAFT),
+	string(fabricv4.CONNECTIONSTATE_FAILED),
+	string(fabricv4.CONNECTIONSTATE_PENDING),
+	string(fabricv4.CONNECTIONSTATE_PROVISIONED),
+	string(fabricv4.CONNECTIONSTATE_PROVISIONING),
+	string(fabricv4.CONNECTIONSTATE_REPROVISIONING),
+}
+
+// NewListResource creates a list resource for Fabric connections
+func NewListResource() list.ListResource {
+	return &ListResource{
+		SDKv2ListResource: framework.NewSDKv2ListResource(
+			framework.BaseListResourceConfig{
+				Name: "equinix_fabric_connection",
+			},
+			Resource(),
+		),
+	}
+}
+
+// ListResource lists Fabric connections matching the given filters
+type ListResource struct {
+	framework.SDKv2ListResource
+}
+
+type listResourceModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	Name      types.String `tfsdk:"name"`
+	States    types.List   `tfsdk:"states"`
+}
+
+// ListResourceConfigSchema returns the schema of the list block filters
+func (r *ListResource) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	var stateValues []string
+	for _, state := range fabricv4.AllowedConnectionStateEnumValues {
+		if state != fabricv4.CONNECTIONSTATE_EMPTY {
+			stateValues = append(stateValues, string(state))
+		}
+	}
+	resp.Schema = schema.Schema{
+		Description: "Lists the Fabric connections matching the given filters",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "Project ID the connections belong to",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the connections",
+				Optional:    true,
+			},
+			"states": schema.ListAttribute{
+				Description: fmt.Sprintf("States of the connections. Defaults to all states except DEPROVISIONED and CANCELLED. One of %v", stateValues),
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(stateValues...)),
+				},
+			},
+		},
+	}
+}
+
+// List searches the connections matching the filters, one page at a time
+func (r *ListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var filters listResourceModel
+	diags := req.Config.Get(ctx, &filters)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	states := listStates
+	if !filters.States.IsNull() {
+		states = nil
+		diags.Append(filters.States.ElementsAs(ctx, &states, false)...)
+		if diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	expressions := []fabricv4.Expression{
+		listExpression(fabricv4.SEARCHFIELDNAME_STATE, fabricv4.EXPRESSIONOPERATOR_IN, states...),
+	}
+	if projectID := filters.ProjectID.ValueString(); projectID != "" {
+		expressions = append(expressions, listExpression(fabricv4.SEARCHFIELDNAME_PROJECT_PROJECT_ID, fabricv4.EXPRESSIONOPERATOR_EQUAL, projectID))
+	}
+	if name := filters.Name.ValueString(); name != "" {
+		expressions = append(expressions, listExpression(fabricv4.SEARCHFIELDNAME_NAME, fabricv4.EXPRESSIONOPERATOR_EQUAL, name))
+	}
+	filter := fabricv4.Expression{}
+	filter.SetAnd(expressions)
+
+	client := r.Meta.NewFabricClientForList(ctx)
+	items := framework.Paginate(func(offset int32) ([]framework.ListItem, bool, error) {
+		pagination := fabricv4.PaginationRequest{}
+		pagination.SetOffset(offset)
+		pagination.SetLimit(framework.ListPageSize)
+		searchRequest := fabricv4.SearchRequest{}
+		searchRequest.SetFilter(filter)
+		searchRequest.SetPagination(pagination)
+		connections, _, err := client.ConnectionsApi.SearchConnections(ctx).SearchRequest(searchRequest).Execute()
+		if err != nil {
+			return nil, false, equinix_errors.FormatFabricError(err)
+		}
+		items := make([]framework.ListItem, len(connections.GetData()))
+		for i, connection := range connections.GetData() {
+			items[i] = framework.ListItem{ID: connection.GetUuid(), DisplayName: connection.GetName()}
+		}
+		return items, framework.HasMorePages(offset, len(items), connections.Pagination.GetTotal()), nil
+	})
+	stream.Results = r.Results(ctx, req, items)
+}
+
+func listExpression(property fabricv4.SearchFieldName, operator fabricv4.ExpressionOperator, values ...string) fabricv4.Expression {
+	expression := fabricv4.Expression{}
+	expression.SetProperty(property)
+	expression.SetOperator(operator)
+	expression.SetValues(values)
+	return expression
+}
@@ -1,10 +1,10 @@
 package connection
 
 import (
-	"fmt"
 	"log"
 	"reflect"
 	"sort"
+	"time"
 
 	equinix_fabric_schema "github.com/equinix/terraform-provider-equinix/internal/fabric/schema"
 	equinix_schema "github.com/equinix/terraform-provider-equinix/internal/schema"
@@ -33,6 +33,11 @@ func additionalInfoContainsAWSSecrets(info []any) ([]any, bool) {
 func setFabricMap(d *schema.ResourceData, conn *fabricv4.Connection) diag.Diagnostics {
 	diags := diag.Diagnostics{}
 	connection := connectionMap(conn)
+	// With a bandwidth schedule the provisioned bandwidth is only reported
+	// as effective_bandwidth, bandwidth keeps the configured base value
+	if schedule, ok := d.Get("bandwidth_schedule").([]any); ok && len(schedule) > 0 {
+		delete(connection, "bandwidth")
+	}
 	err := equinix_schema.SetMap(d, connection)
 	if err != nil {
 		return diag.FromErr(err)
@@ -45,6 +50,7 @@ func connectionMap(conn *fabricv4.Connection) map[string]any {
 	connection["name"] = conn.GetName()
 	connection["uuid"] = conn.GetUuid()
 	connection["bandwidth"] = conn.GetBandwidth()
+	connection["effective_bandwidth"] = conn.GetBandwidth()
 	connection["geo_scope"] = string(conn.GetGeoScope())
 	connection["href"] = conn.GetHref()
 	connection["is_remote"] = conn.GetIsRemote()
@@ -91,6 +97,10 @@ func connectionMap(conn *fabricv4.Connection) map[string]any {
 		project := conn.GetProject()
 		connection["project"] = equinix_fabric_schema.ProjectGoToTerraform(&project)
 	}
+	if conn.MarketplaceSubscription != nil {
+		marketplaceSubscription := conn.GetMarketplaceSubscription()
+		connection["marketplace_subscription"] = connectionMarketplaceSubscriptionGoToTerraform(&marketplaceSubscription)
+	}
 
 	return connection
 }
@@ -126,6 +136,33 @@ func connectionRedundancyGoToTerraform(redundancy *fabricv4.ConnectionRedundancy
 	return redundancySet
 }
 
+func connectionMarketplaceSubscriptionTerraformToGo(marketplaceSubscriptionTerraform []any) fabricv4.MarketplaceSubscription {
+	if len(marketplaceSubscriptionTerraform) == 0 {
+		return fabricv4.MarketplaceSubscription{}
+	}
+	marketplaceSubscription := fabricv4.MarketplaceSubscription{}
+	marketplaceSubscriptionMap := marketplaceSubscriptionTerraform[0].(map[string]any)
+	marketplaceSubscription.SetUuid(marketplaceSubscriptionMap["uuid"].(string))
+	if subscriptionType := marketplaceSubscriptionMap["type"].(string); subscriptionType != "" {
+		marketplaceSubscription.SetType(fabricv4.MarketplaceSubscriptionType(subscriptionType))
+	}
+	return marketplaceSubscription
+}
+
+func connectionMarketplaceSubscriptionGoToTerraform(subscription *fabricv4.MarketplaceSubscription) *schema.Set {
+	if subscription == nil {
+		return nil
+	}
+	mappedSubscription := make(map[string]any)
+	mappedSubscription["type"] = string(subscription.GetType())
+	mappedSubscription["uuid"] = subscription.GetUuid()
+	subscriptionSet := schema.NewSet(
+		schema.HashResource(&schema.Resource{Schema: connectionMarketplaceSubscriptionSch()}),
+		[]any{mappedSubscription},
+	)
+	return subscriptionSet
+}
+
 func serviceTokenTerraformToGo(serviceTokenList []any) fabricv4.ServiceToken {
 	if len(serviceTokenList) == 0 {
 		return fabricv4.ServiceToken{}
@@ -676,7 +713,9 @@ func appendReplaceOp(changeOps [][]fabricv4.ConnectionChangeOperation, op string
 	return changeOps
 }
 
-func getUpdateRequests(conn *fabricv4.Connection, d *schema.ResourceData) ([][]fabricv4.ConnectionChangeOperation, error) {
+// getUpdateRequests returns the change operations to send for the connection
+// to match the planned values, none when it already matches them
+func getUpdateRequests(conn *fabricv4.Connection, d *schema.ResourceData) [][]fabricv4.ConnectionChangeOperation {
 	var changeOps [][]fabricv4.ConnectionChangeOperation
 	existingName := conn.GetName()
 
@@ -698,6 +737,9 @@ func getUpdateRequests(conn *fabricv4.Connection, d *schema.ResourceData) ([][]f
 	existingBandwidth := int(conn.GetBandwidth())
 	updateNameVal := d.Get("name").(string)
 	updateBandwidthVal := d.Get("bandwidth").(int)
+	if schedule, ok := d.Get("bandwidth_schedule").([]any); ok && len(schedule) > 0 {
+		updateBandwidthVal = d.Get("effective_bandwidth").(int)
+	}
 	updateAsideVlan := getVlan(connectionSideTerraformToGo(d.Get("a_side").(*schema.Set).List()).AccessPoint)
 	additionalInfo := d.Get("additional_info").([]any)
 
@@ -741,11 +783,41 @@ func getUpdateRequests(conn *fabricv4.Connection, d *schema.ResourceData) ([][]f
 		})
 	}
 
-	if len(changeOps) == 0 {
-		return changeOps, fmt.Errorf("nothing to update for the connection %s", existingName)
+	return changeOps
+}
+
+// bandwidthWindow is a time window of a connection bandwidth schedule
+type bandwidthWindow struct {
+	start     time.Time
+	end       time.Time
+	bandwidth int
+}
+
+func bandwidthScheduleTerraformToGo(scheduleList []any) []bandwidthWindow {
+	schedule := make([]bandwidthWindow, 0, len(scheduleList))
+	for _, window := range scheduleList {
+		windowMap := window.(map[string]any)
+		// Time formats are checked by the schema validation
+		start, _ := time.Parse(time.RFC3339, windowMap["start_date_time"].(string))
+		end, _ := time.Parse(time.RFC3339, windowMap["end_date_time"].(string))
+		schedule = append(schedule, bandwidthWindow{
+			start:     start,
+			end:       end,
+			bandwidth: windowMap["bandwidth"].(int),
+		})
 	}
+	return schedule
+}
 
-	return changeOps, nil
+// scheduledBandwidth returns the bandwidth of the first schedule window
+// containing the given time, or the base bandwidth outside of all windows
+func scheduledBandwidth(base int, schedule []bandwidthWindow, at time.Time) int {
+	for _, window := range schedule {
+		if !at.Before(window.start) && at.Before(window.end) {
+			return window.bandwidth
+		}
+	}
+	return base
 }
 
 func portTerraformToGo(portList []any) fabricv4.SimplifiedPort {
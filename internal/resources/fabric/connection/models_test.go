@@ -0,0 +1,207 @@
+package connection
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduledBandwidth(t *testing.T) {
+	// given
+	schedule := bandwidthScheduleTerraformToGo([]any{
+		map[string]any{
+			"start_date_time": "2026-11-27T00:00:00Z",
+			"end_date_time":   "2026-11-30T00:00:00Z",
+			"bandwidth":       1000,
+		},
+		map[string]any{
+			"start_date_time": "2026-11-29T00:00:00Z",
+			"end_date_time":   "2026-12-02T00:00:00Z",
+			"bandwidth":       500,
+		},
+	})
+	at := func(value string) time.Time {
+		parsed, _ := time.Parse(time.RFC3339, value)
+		return parsed
+	}
+
+	// when
+	before := scheduledBandwidth(100, schedule, at("2026-11-26T23:59:59Z"))
+	windowStart := scheduledBandwidth(100, schedule, at("2026-11-27T00:00:00Z"))
+	overlap := scheduledBandwidth(100, schedule, at("2026-11-29T12:00:00Z"))
+	secondWindow := scheduledBandwidth(100, schedule, at("2026-11-30T00:00:00Z"))
+	windowEnd := scheduledBandwidth(100, schedule, at("2026-12-02T00:00:00Z"))
+
+	// then
+	assert.Len(t, schedule, 2)
+	assert.Equal(t, 100, before, "Base bandwidth applies before the schedule")
+	assert.Equal(t, 1000, windowStart, "Window bandwidth applies from the window start")
+	assert.Equal(t, 1000, overlap, "First window wins on overlaps")
+	assert.Equal(t, 500, secondWindow, "Second window applies after the first one ends")
+	assert.Equal(t, 100, windowEnd, "Base bandwidth applies from the window end")
+}
+
+func TestScheduledBandwidth_NoSchedule(t *testing.T) {
+	// given
+	schedule := bandwidthScheduleTerraformToGo(nil)
+
+	// when
+	bandwidth := scheduledBandwidth(50, schedule, time.Now())
+
+	// then
+	assert.Empty(t, schedule)
+	assert.Equal(t, 50, bandwidth)
+}
+
+func TestValidateConnectionSidesDiversity(t *testing.T) {
+	// given
+	portSide := func(uuid string) fabricv4.ConnectionSide {
+		return fabricv4.ConnectionSide{AccessPoint: &fabricv4.AccessPoint{
+			Port: &fabricv4.SimplifiedPort{Uuid: fabricv4.PtrString(uuid)},
+		}}
+	}
+	virtualDeviceSide := func(uuid string) fabricv4.ConnectionSide {
+		return fabricv4.ConnectionSide{AccessPoint: &fabricv4.AccessPoint{
+			VirtualDevice: &fabricv4.VirtualDevice{Uuid: fabricv4.PtrString(uuid)},
+		}}
+	}
+	profileSide := fabricv4.ConnectionSide{AccessPoint: &fabricv4.AccessPoint{
+		Profile: &fabricv4.SimplifiedServiceProfile{Uuid: fabricv4.PtrString("profile-uuid")},
+	}}
+
+	// when
+	samePort := validateConnectionSidesDiversity("a_side", portSide("port-1"), portSide("port-1"))
+	diversePorts := validateConnectionSidesDiversity("a_side", portSide("port-1"), portSide("port-2"))
+	sameVirtualDevice := validateConnectionSidesDiversity("a_side", virtualDeviceSide("vd-1"), virtualDeviceSide("vd-1"))
+	portAndVirtualDevice := validateConnectionSidesDiversity("a_side", portSide("shared"), virtualDeviceSide("shared"))
+	sameProfile := validateConnectionSidesDiversity("z_side", profileSide, profileSide)
+
+	// then
+	assert.ErrorContains(t, samePort, "a_side of the primary and secondary connections are both on port port-1")
+	assert.NoError(t, diversePorts)
+	assert.ErrorContains(t, sameVirtualDevice, "both on virtual device vd-1")
+	assert.NoError(t, portAndVirtualDevice)
+	assert.NoError(t, sameProfile, "Service profiles are not required to be diverse")
+}
+
+func testUpdateRequestsConnection(bandwidth int32) *fabricv4.Connection {
+	return &fabricv4.Connection{
+		Name:      "test_connection",
+		Bandwidth: bandwidth,
+		Notifications: []fabricv4.SimplifiedNotification{{
+			Type:   fabricv4.SIMPLIFIEDNOTIFICATIONTYPE_ALL,
+			Emails: []string{"test@equinix.com"},
+		}},
+		Operation: &fabricv4.ConnectionOperation{
+			ProviderStatus: fabricv4.PROVIDERSTATUS_PROVISIONED.Ptr(),
+		},
+	}
+}
+
+func testUpdateRequestsResourceData(t *testing.T, schedule []any) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, fabricConnectionResourceSchema(), map[string]any{
+		"name":               "test_connection",
+		"bandwidth":          50,
+		"bandwidth_schedule": schedule,
+		"notifications": []any{map[string]any{
+			"type":   "ALL",
+			"emails": []any{"test@equinix.com"},
+		}},
+	})
+}
+
+func TestGetUpdateRequests_plannedEffectiveBandwidth(t *testing.T) {
+	// given
+	d := testUpdateRequestsResourceData(t, []any{map[string]any{
+		"start_date_time": "2020-01-01T00:00:00Z",
+		"end_date_time":   "2020-01-02T00:00:00Z",
+		"bandwidth":       1000,
+	}})
+	// The window was active when planned and has ended since
+	assert.NoError(t, d.Set("effective_bandwidth", 1000))
+
+	// when
+	updateRequests := getUpdateRequests(testUpdateRequestsConnection(50), d)
+
+	// then
+	assert.Len(t, updateRequests, 1)
+	assert.Equal(t, "/bandwidth", updateRequests[0][0].Path)
+	assert.Equal(t, 1000, updateRequests[0][0].Value, "Planned effective bandwidth is applied")
+}
+
+func TestGetUpdateRequests_nothingToUpdate(t *testing.T) {
+	// given
+	d := testUpdateRequestsResourceData(t, nil)
+
+	// when
+	updateRequests := getUpdateRequests(testUpdateRequestsConnection(50), d)
+
+	// then
+	assert.Empty(t, updateRequests, "No change operation is sent for a connection matching the plan")
+}
+
+func TestRedundantConnectionDiff_missingConnection(t *testing.T) {
+	// given
+	member := func(name, portUUID string) []any {
+		side := []any{map[string]any{
+			"access_point": []any{map[string]any{
+				"type": "COLO",
+				"port": []any{map[string]any{"uuid": portUUID}},
+			}},
+		}}
+		return []any{map[string]any{"name": name, "a_side": side, "z_side": side}}
+	}
+	raw := map[string]any{
+		"type":      "EVPL_VC",
+		"bandwidth": 50,
+		"notifications": []any{map[string]any{
+			"type":   "ALL",
+			"emails": []any{"test@equinix.com"},
+		}},
+		redundantConnectionPrimary:   member("primary", "port-1"),
+		redundantConnectionSecondary: member("secondary", "port-2"),
+	}
+	redundant := RedundantResource()
+	d := schema.TestResourceDataRaw(t, redundant.Schema, raw)
+	d.SetId("redundancy-group")
+	assert.NoError(t, d.Set("secondary_connection_id", "secondary-uuid"))
+	// The primary connection was deleted outside of Terraform
+	assert.NoError(t, d.Set(redundantConnectionPrimary, []any{}))
+	state := d.State()
+
+	// when
+	diff, err := redundant.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+
+	// then
+	assert.NoError(t, err)
+	assert.True(t, diff.RequiresNew(), "The pair is replaced")
+}
+
+func TestRedundantConnectionBandwidth(t *testing.T) {
+	// given
+	members := func(primary, secondary int32) map[string]*fabricv4.Connection {
+		return map[string]*fabricv4.Connection{
+			redundantConnectionPrimary:   {Bandwidth: primary},
+			redundantConnectionSecondary: {Bandwidth: secondary},
+		}
+	}
+
+	// when
+	inSync := redundantConnectionBandwidth(50, members(50, 50))
+	secondaryDrift := redundantConnectionBandwidth(50, members(50, 100))
+	primaryDrift := redundantConnectionBandwidth(50, members(200, 50))
+	missingPrimary := redundantConnectionBandwidth(50, map[string]*fabricv4.Connection{
+		redundantConnectionSecondary: {Bandwidth: 100},
+	})
+
+	// then
+	assert.Equal(t, 50, inSync)
+	assert.Equal(t, 100, secondaryDrift, "Drift on the secondary connection is reported")
+	assert.Equal(t, 200, primaryDrift, "Drift on the primary connection is reported")
+	assert.Equal(t, 100, missingPrimary, "Remaining connection is compared")
+}
@@ -5,15 +5,17 @@ import (
 	"fmt"
 	"log"
 	"slices"
-	"strings"
 	"time"
 
 	"github.com/equinix/terraform-provider-equinix/internal/config"
 	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
 	equinix_fabric_schema "github.com/equinix/terraform-provider-equinix/internal/fabric/schema"
+	"github.com/equinix/terraform-provider-equinix/internal/importer"
+	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/marketplace"
 
 	"github.com/equinix/equinix-sdk-go/services/fabricv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -31,62 +33,105 @@ func Resource() *schema.Resource {
 		CreateContext: resourceFabricConnectionCreate,
 		UpdateContext: resourceFabricConnectionUpdate,
 		DeleteContext: resourceFabricConnectionDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Schema: fabricConnectionResourceSchema(),
-		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
-			if d.Id() == "" {
-				return nil
-			}
+		Importer:      importer.UUIDImporter(),
+		Identity:      importer.UUIDIdentity(),
+		Schema:        fabricConnectionResourceSchema(),
+		CustomizeDiff: customdiff.All(
+			validateConnectionVlanChange,
+			planScheduledBandwidth,
+			customdiff.IfValueChange("marketplace_subscription", func(_ context.Context, _, newValue, _ any) bool {
+				return newValue.(*schema.Set).Len() > 0
+			}, marketplace.ValidateSubscriptionDiff),
+		),
 
-			if !d.HasChange("a_side") {
-				return nil
-			}
+		Description: "Fabric V4 API compatible resource allows creation and management of Equinix Fabric connection",
+	}
+}
 
-			_oldASide, _newASide := d.GetChange("a_side")
-			oldAside := connectionSideTerraformToGo(_oldASide.(*schema.Set).List())
-			newAside := connectionSideTerraformToGo(_newASide.(*schema.Set).List())
+// planScheduledBandwidth validates the bandwidth schedule and plans the
+// bandwidth the connection should have at the time of the plan, for an
+// apply to change the connection bandwidth when a schedule window starts
+// or ends. The apply sends the planned effective_bandwidth, so that a window
+// boundary passed between plan and apply does not change what is applied
+func planScheduledBandwidth(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown("bandwidth") || !d.NewValueKnown("bandwidth_schedule") {
+		return nil
+	}
+	schedule := bandwidthScheduleTerraformToGo(d.Get("bandwidth_schedule").([]any))
+	for i, window := range schedule {
+		if !window.end.After(window.start) {
+			return fmt.Errorf("bandwidth_schedule.%d: end_date_time must be after start_date_time", i)
+		}
+	}
 
-			oldLinkProtocol := oldAside.GetAccessPoint().LinkProtocol
-			newLinkProtocol := newAside.GetAccessPoint().LinkProtocol
+	if d.Id() == "" {
+		return nil
+	}
+	// Without a schedule the bandwidth is left to the bandwidth attribute, not
+	// to revert changes applied by equinix_fabric_connection_bandwidth_change
+	if len(schedule) == 0 {
+		if d.HasChange("bandwidth") || d.HasChange("bandwidth_schedule") {
+			return d.SetNewComputed("effective_bandwidth")
+		}
+		return nil
+	}
+	bandwidth := scheduledBandwidth(d.Get("bandwidth").(int), schedule, time.Now())
+	if bandwidth != d.Get("effective_bandwidth").(int) {
+		return d.SetNew("effective_bandwidth", bandwidth)
+	}
+	return nil
+}
 
-			if oldLinkProtocol == nil || newLinkProtocol == nil {
-				return nil
-			}
+// validateConnectionVlanChange ensures that vlan tag updates are only planned
+// for connection types and link protocols that support them
+func validateConnectionVlanChange(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" {
+		return nil
+	}
 
-			allowedTypesForVlanChange := []string{string(fabricv4.CONNECTIONTYPE_EVPL_VC), string(fabricv4.CONNECTIONTYPE_EIA_VC)}
+	if !d.HasChange("a_side") {
+		return nil
+	}
 
-			connType := d.Get("type").(string)
-			if oldLinkProtocol.VlanTag != nil && newLinkProtocol.VlanTag != nil && *oldLinkProtocol.VlanTag != *newLinkProtocol.VlanTag {
-				if !slices.Contains(allowedTypesForVlanChange, connType) {
-					return fmt.Errorf(
-						"vlan update not allowed for connection of type %s",
-						connType,
-					)
-				}
+	_oldASide, _newASide := d.GetChange("a_side")
+	oldAside := connectionSideTerraformToGo(_oldASide.(*schema.Set).List())
+	newAside := connectionSideTerraformToGo(_newASide.(*schema.Set).List())
 
-				if newLinkProtocol.Type == nil || oldLinkProtocol.Type == nil {
-					return fmt.Errorf("invalid link protocol state")
-				}
+	oldLinkProtocol := oldAside.GetAccessPoint().LinkProtocol
+	newLinkProtocol := newAside.GetAccessPoint().LinkProtocol
 
-				if *oldLinkProtocol.Type != *newLinkProtocol.Type {
-					return fmt.Errorf("link protocol type update not allowed")
-				}
+	if oldLinkProtocol == nil || newLinkProtocol == nil {
+		return nil
+	}
 
-				if *newLinkProtocol.Type != fabricv4.LINKPROTOCOLTYPE_DOT1_Q {
-					return fmt.Errorf(
-						"vlan update not allowed for link protocol of type %s",
-						*newLinkProtocol.Type,
-					)
-				}
-			}
+	allowedTypesForVlanChange := []string{string(fabricv4.CONNECTIONTYPE_EVPL_VC), string(fabricv4.CONNECTIONTYPE_EIA_VC)}
 
-			return nil
-		},
+	connType := d.Get("type").(string)
+	if oldLinkProtocol.VlanTag != nil && newLinkProtocol.VlanTag != nil && *oldLinkProtocol.VlanTag != *newLinkProtocol.VlanTag {
+		if !slices.Contains(allowedTypesForVlanChange, connType) {
+			return fmt.Errorf(
+				"vlan update not allowed for connection of type %s",
+				connType,
+			)
+		}
 
-		Description: "Fabric V4 API compatible resource allows creation and management of Equinix Fabric connection",
+		if newLinkProtocol.Type == nil || oldLinkProtocol.Type == nil {
+			return fmt.Errorf("invalid link protocol state")
+		}
+
+		if *oldLinkProtocol.Type != *newLinkProtocol.Type {
+			return fmt.Errorf("link protocol type update not allowed")
+		}
+
+		if *newLinkProtocol.Type != fabricv4.LINKPROTOCOLTYPE_DOT1_Q {
+			return fmt.Errorf(
+				"vlan update not allowed for link protocol of type %s",
+				*newLinkProtocol.Type,
+			)
+		}
 	}
+
+	return nil
 }
 
 func resourceFabricConnectionCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -109,7 +154,7 @@ func resourceFabricConnectionCreate(ctx context.Context, d *schema.ResourceData,
 	notifications := equinix_fabric_schema.NotificationsTerraformToGo(schemaNotifications)
 	createConnectionRequest.SetNotifications(notifications)
 
-	bandwidth := d.Get("bandwidth").(int)
+	bandwidth := scheduledBandwidth(d.Get("bandwidth").(int), bandwidthScheduleTerraformToGo(d.Get("bandwidth_schedule").([]any)), time.Now())
 	createConnectionRequest.SetBandwidth(int32(bandwidth))
 
 	geoScope := d.Get("geo_scope").(string)
@@ -127,6 +172,11 @@ func resourceFabricConnectionCreate(ctx context.Context, d *schema.ResourceData,
 		createConnectionRequest.SetProject(project)
 	}
 
+	if schemaMarketplaceSubscription, ok := d.GetOk("marketplace_subscription"); ok {
+		marketplaceSubscription := connectionMarketplaceSubscriptionTerraformToGo(schemaMarketplaceSubscription.(*schema.Set).List())
+		createConnectionRequest.SetMarketplaceSubscription(marketplaceSubscription)
+	}
+
 	aSide := d.Get("a_side").(*schema.Set).List()
 	connectionASide := connectionSideTerraformToGo(aSide)
 	createConnectionRequest.SetASide(connectionASide)
@@ -186,16 +236,21 @@ func resourceFabricConnectionCreate(ctx context.Context, d *schema.ResourceData,
 
 func resourceFabricConnectionRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
-	conn, _, err := client.ConnectionsApi.GetConnectionByUuid(ctx, d.Id()).Execute()
+	conn, httpResp, err := client.ConnectionsApi.GetConnectionByUuid(ctx, d.Id()).Execute()
+	if err == nil {
+		err = equinix_errors.CheckFabricDeletedState(d.Id(), string(conn.GetState()))
+	}
 	if err != nil {
-		log.Printf("[WARN] Connection %s not found , error %s", d.Id(), err)
-		if !strings.Contains(err.Error(), "500") {
-			d.SetId("")
-		}
-		return diag.FromErr(equinix_errors.FormatFabricError(err))
+		return equinix_errors.FabricReadDiagnostics(d, httpResp, err)
 	}
 	d.SetId(conn.GetUuid())
-	return setFabricMap(d, conn)
+	if diags := setFabricMap(d, conn); diags.HasError() {
+		return diags
+	}
+	if err := importer.SetUUIDIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
 func resourceFabricConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -204,17 +259,19 @@ func resourceFabricConnectionUpdate(ctx context.Context, d *schema.ResourceData,
 	updateTimeout := d.Timeout(schema.TimeoutUpdate) - 30*time.Second - time.Since(start)
 	dbConn, err := verifyConnectionCreated(ctx, d.Id(), meta, d, updateTimeout)
 	if err != nil {
-		if !strings.Contains(err.Error(), "500") {
-			d.SetId("")
-		}
 		return diag.Errorf("either timed out or errored out while fetching connection for uuid %s: error -> %v", d.Id(), err)
 	}
 
 	diags := diag.Diagnostics{}
-	updateRequests, err := getUpdateRequests(dbConn, d)
-	if err != nil {
-		diags = append(diags, diag.Diagnostic{Severity: 1, Summary: err.Error()})
-		return diags
+	updateRequests := getUpdateRequests(dbConn, d)
+	if len(updateRequests) == 0 {
+		if d.HasChangesExcept("bandwidth", "bandwidth_schedule", "effective_bandwidth") {
+			diags = append(diags, diag.Diagnostic{Severity: 1, Summary: fmt.Sprintf("nothing to update for the connection %s", dbConn.GetName())})
+			return diags
+		}
+		// Bandwidth and schedule changes not affecting the provisioned
+		// bandwidth only update state
+		return setFabricMap(d, dbConn)
 	}
 	updatedConn := dbConn
 
@@ -377,28 +434,36 @@ func verifyConnectionCreated(ctx context.Context, uuid string, meta any, d *sche
 }
 
 func resourceFabricConnectionDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	diags := diag.Diagnostics{}
+	deleteTimeout := d.Timeout(schema.TimeoutDelete) - 30*time.Second
+	if err := deleteConnection(ctx, d.Id(), meta, d, deleteTimeout); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// deleteConnection deletes the connection and waits until it is
+// deprovisioned, a connection already deleted is not an error
+func deleteConnection(ctx context.Context, uuid string, meta any, d *schema.ResourceData, timeout time.Duration) error {
 	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
 	start := time.Now()
-	_, _, err := client.ConnectionsApi.DeleteConnectionByUuid(ctx, d.Id()).Execute()
+	_, _, err := client.ConnectionsApi.DeleteConnectionByUuid(ctx, uuid).Execute()
 	if err != nil {
 		if genericError, ok := err.(*fabricv4.GenericOpenAPIError); ok {
 			if fabricErrs, ok := genericError.Model().([]fabricv4.Error); ok {
 				// EQ-3142509 = Connection already deleted
 				if equinix_errors.HasErrorCode(fabricErrs, "EQ-3142509") {
-					return diags
+					return nil
 				}
 			}
 		}
-		return diag.FromErr(equinix_errors.FormatFabricError(err))
+		return equinix_errors.FormatFabricError(err)
 	}
 
-	deleteTimeout := d.Timeout(schema.TimeoutDelete) - 30*time.Second - time.Since(start)
-	err = WaitUntilConnectionDeprovisioned(ctx, d.Id(), meta, d, deleteTimeout)
+	err = WaitUntilConnectionDeprovisioned(ctx, uuid, meta, d, timeout-time.Since(start))
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("API call failed while waiting for connection deletion. ID: %s, Error %v", d.Id(), err))
+		return fmt.Errorf("API call failed while waiting for connection deletion. ID: %s, Error %v", uuid, err)
 	}
-	return diags
+	return nil
 }
 
 // WaitUntilConnectionDeprovisioned waits until the connection is in DEPROVISIONED state, which indicates that the connection has been deleted successfully. This is required as the API allows deletion of the resource, but the actual resource gets deleted only after it is in DEPROVISIONED state.
@@ -0,0 +1,174 @@
+package connection
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/equinix/terraform-provider-equinix/internal/config"
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/importer"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// BandwidthChangeResource returns the schema.Resource applying a temporary
+// bandwidth to an Equinix Fabric connection, reverted on destroy
+func BandwidthChangeResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+		},
+		ReadContext:   resourceFabricConnectionBandwidthChangeRead,
+		CreateContext: resourceFabricConnectionBandwidthChangeCreate,
+		DeleteContext: resourceFabricConnectionBandwidthChangeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFabricConnectionBandwidthChangeImport,
+		},
+		Schema: fabricConnectionBandwidthChangeResourceSchema(),
+
+		Description: `Fabric V4 API compatible resource allows temporarily changing the bandwidth of an Equinix Fabric connection
+
+The bandwidth the connection had before the change is restored on destroy. Ignore changes to the bandwidth of the equinix_fabric_connection resource managing the connection, for it not to revert the change. The resource ID is composed of the connection uuid and the previous bandwidth, in the form of connection_id/previous_bandwidth, which is also the format to import it with.
+
+Additional documentation:
+* Getting Started: https://docs.equinix.com/fabric/connections/
+* API: https://docs.equinix.com/api-catalog/fabricv4/#tag/Connections`,
+	}
+}
+
+func fabricConnectionBandwidthChangeResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"connection_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The uuid of the connection to change the bandwidth of",
+		},
+		"bandwidth": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Connection bandwidth in Mbps while the resource exists",
+		},
+		"previous_bandwidth": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Connection bandwidth in Mbps before the change, restored on destroy",
+		},
+	}
+}
+
+func resourceFabricConnectionBandwidthChangeCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	start := time.Now()
+	uuid := d.Get("connection_id").(string)
+	createTimeout := d.Timeout(schema.TimeoutCreate) - 30*time.Second - time.Since(start)
+	conn, err := verifyConnectionCreated(ctx, uuid, meta, d, createTimeout)
+	if err != nil {
+		return diag.Errorf("either timed out or errored out while fetching connection for uuid %s: error -> %v", uuid, err)
+	}
+
+	previousBandwidth := int(conn.GetBandwidth())
+	bandwidth := d.Get("bandwidth").(int)
+	if bandwidth != previousBandwidth {
+		createTimeout = d.Timeout(schema.TimeoutCreate) - 30*time.Second - time.Since(start)
+		if err := replaceConnectionValue(ctx, uuid, "/bandwidth", bandwidth, meta, d, createTimeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(bandwidthChangeID(uuid, previousBandwidth))
+	if err := d.Set("previous_bandwidth", previousBandwidth); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceFabricConnectionBandwidthChangeRead(ctx, d, meta)
+}
+
+// bandwidthChangeID returns the ID of a bandwidth change, for changes applied
+// one after the other to the same connection to have different IDs
+func bandwidthChangeID(uuid string, previousBandwidth int) string {
+	return importer.CompositeID(uuid, strconv.Itoa(previousBandwidth))
+}
+
+func resourceFabricConnectionBandwidthChangeImport(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	parts, err := importer.ParseCompositeID(d.Id(), "connection_id", "previous_bandwidth")
+	if err != nil {
+		return nil, err
+	}
+	previousBandwidth, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("unexpected previous_bandwidth %q in ID (%s): %s", parts[1], d.Id(), err)
+	}
+	if err := d.Set("connection_id", parts[0]); err != nil {
+		return nil, fmt.Errorf("error setting connection_id: %s", err)
+	}
+	if err := d.Set("previous_bandwidth", previousBandwidth); err != nil {
+		return nil, fmt.Errorf("error setting previous_bandwidth: %s", err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceFabricConnectionBandwidthChangeRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
+	uuid := d.Get("connection_id").(string)
+	conn, httpResp, err := client.ConnectionsApi.GetConnectionByUuid(ctx, uuid).Execute()
+	if err == nil {
+		err = equinix_errors.CheckFabricDeletedState(uuid, string(conn.GetState()))
+	}
+	if err != nil {
+		return equinix_errors.FabricReadDiagnostics(d, httpResp, err)
+	}
+	// Bandwidth changed outside of the resource shows up as drift
+	if err := d.Set("bandwidth", int(conn.GetBandwidth())); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceFabricConnectionBandwidthChangeDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	start := time.Now()
+	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
+	uuid := d.Get("connection_id").(string)
+	conn, httpResp, err := client.ConnectionsApi.GetConnectionByUuid(ctx, uuid).Execute()
+	if err == nil {
+		err = equinix_errors.CheckFabricDeletedState(uuid, string(conn.GetState()))
+	}
+	if err != nil {
+		if equinix_errors.IsFabricNotFound(httpResp, err) {
+			return nil
+		}
+		return diag.FromErr(equinix_errors.FormatFabricError(err))
+	}
+
+	previousBandwidth := d.Get("previous_bandwidth").(int)
+	if int(conn.GetBandwidth()) == previousBandwidth {
+		return nil
+	}
+	deleteTimeout := d.Timeout(schema.TimeoutDelete) - 30*time.Second - time.Since(start)
+	if err := replaceConnectionValue(ctx, uuid, "/bandwidth", previousBandwidth, meta, d, deleteTimeout); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// replaceConnectionValue replaces the value at the path of the connection and
+// waits for the change to complete
+func replaceConnectionValue(ctx context.Context, uuid, path string, value any, meta any, d *schema.ResourceData, timeout time.Duration) error {
+	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
+	for _, update := range appendReplaceOp(nil, path, true, value) {
+		_, _, err := client.ConnectionsApi.UpdateConnectionByUuid(ctx, uuid).ConnectionChangeOperation(update).Execute()
+		if err != nil {
+			return fmt.Errorf("error replacing %s of connection %s with %v: %v", path, uuid, value, equinix_errors.FormatFabricError(err))
+		}
+	}
+	if _, err := waitForConnectionUpdateCompletion(ctx, uuid, meta, d, timeout); err != nil {
+		return fmt.Errorf("error waiting for %s of connection %s to be replaced with %v: %v", path, uuid, value, err)
+	}
+	return nil
+}
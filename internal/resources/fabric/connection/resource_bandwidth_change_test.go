@@ -0,0 +1,86 @@
+package connection_test
+
+import (
+	"testing"
+
+	"github.com/equinix/terraform-provider-equinix/internal/acceptance"
+	testinghelpers "github.com/equinix/terraform-provider-equinix/internal/fabric/testing_helpers"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccFabricConnectionBandwidthChange_PFCR(t *testing.T) {
+	ports := testinghelpers.GetFabricEnvPorts(t)
+	var aSidePortUUID, zSidePortUUID string
+	if len(ports) > 0 {
+		aSidePortUUID = ports["pfcr"]["dot1q"][0].GetUuid()
+		zSidePortUUID = ports["pfcr"]["dot1q"][1].GetUuid()
+	}
+
+	asideVlan, err := testinghelpers.RandomVlan(aSidePortUUID)
+	if err != nil {
+		t.Fatalf("unable to get a available VLAN: %s", err)
+		return
+	}
+
+	zsideVlan, err := testinghelpers.RandomVlan(zSidePortUUID)
+	if err != nil {
+		t.Fatalf("unable to get a available VLAN: %s", err)
+		return
+	}
+
+	variables := config.Variables{
+		"aside_vlan":      config.IntegerVariable(asideVlan),
+		"aside_port_uuid": config.StringVariable(aSidePortUUID),
+		"zside_vlan":      config.IntegerVariable(zsideVlan),
+		"zside_port_uuid": config.StringVariable(zSidePortUUID),
+		"bandwidth":       config.IntegerVariable(50),
+		"name":            config.StringVariable("port_bw_change_PFCR"),
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: CheckConnectionDelete,
+		Steps: []resource.TestStep{
+			{
+				Config:          port2PortConnectionConfig + connectionBandwidthChangeConfig,
+				ConfigVariables: variables,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("equinix_fabric_connection_bandwidth_change.test", tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("equinix_fabric_connection_bandwidth_change.test", tfjsonpath.New("bandwidth"), knownvalue.Int64Exact(100)),
+					statecheck.ExpectKnownValue("equinix_fabric_connection_bandwidth_change.test", tfjsonpath.New("previous_bandwidth"), knownvalue.Int64Exact(50)),
+				},
+				// The connection resource does not ignore changes to its
+				// bandwidth, so it immediately plans to revert the temporary
+				// bandwidth to the configured one
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				ResourceName:      "equinix_fabric_connection_bandwidth_change.test",
+				ConfigVariables:   variables,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:          port2PortConnectionConfig,
+				ConfigVariables: variables,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("equinix_fabric_connection.test", tfjsonpath.New("bandwidth"), knownvalue.Int64Exact(50)),
+					statecheck.ExpectKnownValue("equinix_fabric_connection.test", tfjsonpath.New("effective_bandwidth"), knownvalue.Int64Exact(50)),
+				},
+			},
+		},
+	})
+}
+
+var connectionBandwidthChangeConfig = `
+resource "equinix_fabric_connection_bandwidth_change" "test" {
+  connection_id = equinix_fabric_connection.test.id
+  bandwidth     = 100
+}
+`
@@ -0,0 +1,463 @@
+package connection
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/equinix/terraform-provider-equinix/internal/config"
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	equinix_fabric_schema "github.com/equinix/terraform-provider-equinix/internal/fabric/schema"
+	equinix_schema "github.com/equinix/terraform-provider-equinix/internal/schema"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	redundantConnectionPrimary   = "primary"
+	redundantConnectionSecondary = "secondary"
+)
+
+// RedundantResource returns the schema.Resource for managing a primary and
+// secondary pair of Equinix Fabric connections in the same redundancy group
+func RedundantResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+		},
+		ReadContext:   resourceFabricRedundantConnectionRead,
+		CreateContext: resourceFabricRedundantConnectionCreate,
+		UpdateContext: resourceFabricRedundantConnectionUpdate,
+		DeleteContext: resourceFabricRedundantConnectionDelete,
+		Schema:        fabricRedundantConnectionResourceSchema(),
+		CustomizeDiff: customdiff.All(
+			validateRedundantConnectionDiversity,
+			forceNewRedundantConnectionSides,
+		),
+
+		Description: `Fabric V4 API compatible resource allows creation and management of a redundant pair of Equinix Fabric connections
+
+The primary connection is created first, the secondary connection joins its redundancy group. Should the creation of either connection fail, the connections already created are deleted. The secondary connection must use a different port or virtual device than the primary connection on both sides, for the pair to take diverse paths.
+
+~> **NOTE:** Only the names of the connections and their bandwidth can be updated in place, any other change replaces both connections. Should either connection be deleted outside of Terraform, the next plan replaces the pair, deleting the remaining connection.
+
+Additional documentation:
+* Getting Started: https://docs.equinix.com/fabric/connections/
+* API: https://docs.equinix.com/api-catalog/fabricv4/#tag/Connections`,
+	}
+}
+
+func fabricRedundantConnectionResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice([]string{"EVPL_VC", "EPL_VC", "IP_VC", "IPWAN_VC", "ACCESS_EPL_VC", "EVPLAN_VC", "EPLAN_VC", "EIA_VC", "IA_VC", "EC_VC", "EVPTREE_VC"}, false),
+			Description:  "Defines the connection type of both connections like EVPL_VC, EPL_VC, IPWAN_VC, IP_VC, ACCESS_EPL_VC, EVPLAN_VC, EPLAN_VC, EIA_VC, IA_VC, EC_VC",
+		},
+		"bandwidth": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Bandwidth in Mbps of both connections",
+		},
+		"notifications": {
+			Type:        schema.TypeList,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Preferences for notifications on configuration or status changes of both connections",
+			Elem: &schema.Resource{
+				Schema: equinix_fabric_schema.NotificationSch(),
+			},
+		},
+		"order": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Order details of both connections",
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: equinix_fabric_schema.OrderSch(),
+			},
+		},
+		"project": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Project information of both connections",
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: equinix_fabric_schema.ProjectSch(),
+			},
+		},
+		redundantConnectionPrimary: {
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Description: "Primary connection of the redundant pair. Changing its sides replaces the pair",
+			Elem: &schema.Resource{
+				Schema: redundantConnectionMemberSch(),
+			},
+		},
+		redundantConnectionSecondary: {
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Description: "Secondary connection of the redundant pair. Changing its sides replaces the pair",
+			Elem: &schema.Resource{
+				Schema: redundantConnectionMemberSch(),
+			},
+		},
+		"primary_connection_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Equinix-assigned uuid of the primary connection",
+		},
+		"secondary_connection_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Equinix-assigned uuid of the secondary connection",
+		},
+		"redundancy_group": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Redundancy group identifier shared by the primary and secondary connections",
+		},
+	}
+}
+
+func redundantConnectionMemberSch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 24),
+			Description:  "Connection name. An alpha-numeric 24 characters string which can include only hyphens and underscores",
+		},
+		"a_side": {
+			Type:        schema.TypeSet,
+			Required:    true,
+			Description: "Requester or Customer side connection configuration object of the multi-segment connection",
+			MaxItems:    1,
+			Elem:        connectionSideSch(),
+		},
+		"z_side": {
+			Type:        schema.TypeSet,
+			Required:    true,
+			Description: "Destination or Provider side connection configuration object of the multi-segment connection",
+			MaxItems:    1,
+			Elem:        connectionSideSch(),
+		},
+	}
+}
+
+// validateRedundantConnectionDiversity ensures the secondary connection does
+// not share a port or virtual device with the primary connection
+func validateRedundantConnectionDiversity(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	primary, primaryOk := redundantConnectionMember(d.Get(redundantConnectionPrimary).([]any))
+	secondary, secondaryOk := redundantConnectionMember(d.Get(redundantConnectionSecondary).([]any))
+	if !primaryOk || !secondaryOk {
+		return nil
+	}
+	for _, side := range []string{"a_side", "z_side"} {
+		primarySide := connectionSideTerraformToGo(primary[side].(*schema.Set).List())
+		secondarySide := connectionSideTerraformToGo(secondary[side].(*schema.Set).List())
+		if err := validateConnectionSidesDiversity(side, primarySide, secondarySide); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// forceNewRedundantConnectionSides replaces the pair when the sides of either
+// connection change, sides of an existing connection not being updatable, or
+// when either connection was deleted outside of Terraform
+func forceNewRedundantConnectionSides(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" {
+		return nil
+	}
+	for _, member := range []string{redundantConnectionPrimary, redundantConnectionSecondary} {
+		missing := d.Get(member+"_connection_id").(string) == "" && d.HasChange(member)
+		if missing || d.HasChange(member+".0.a_side") || d.HasChange(member+".0.z_side") {
+			if err := d.ForceNew(member); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func redundantConnectionMember(memberList []any) (map[string]any, bool) {
+	if len(memberList) == 0 || memberList[0] == nil {
+		return nil, false
+	}
+	member, ok := memberList[0].(map[string]any)
+	return member, ok
+}
+
+// validateConnectionSidesDiversity returns an error when the primary and
+// secondary connection sides are on the same port or virtual device
+func validateConnectionSidesDiversity(side string, primary, secondary fabricv4.ConnectionSide) error {
+	asset := connectionSideAsset(primary)
+	if asset != "" && asset == connectionSideAsset(secondary) {
+		return fmt.Errorf("%s of the primary and secondary connections are both on %s, the secondary connection must use a different port or virtual device for diverse paths", side, asset)
+	}
+	return nil
+}
+
+// connectionSideAsset returns the port or virtual device the access point of
+// the connection side is on, empty for other access points
+func connectionSideAsset(side fabricv4.ConnectionSide) string {
+	accessPoint := side.GetAccessPoint()
+	if port := accessPoint.GetPort(); port.GetUuid() != "" {
+		return "port " + port.GetUuid()
+	}
+	if virtualDevice := accessPoint.GetVirtualDevice(); virtualDevice.GetUuid() != "" {
+		return "virtual device " + virtualDevice.GetUuid()
+	}
+	return ""
+}
+
+func redundantConnectionPostRequest(d *schema.ResourceData, member, group string) fabricv4.ConnectionPostRequest {
+	request := fabricv4.ConnectionPostRequest{}
+	memberMap, _ := redundantConnectionMember(d.Get(member).([]any))
+
+	request.SetName(memberMap["name"].(string))
+	request.SetType(fabricv4.ConnectionType(d.Get("type").(string)))
+	request.SetBandwidth(int32(d.Get("bandwidth").(int)))
+
+	if orderSchema, ok := d.GetOk("order"); ok {
+		order := equinix_fabric_schema.OrderTerraformToGo(orderSchema.(*schema.Set).List())
+		request.SetOrder(order)
+	}
+
+	schemaNotifications := d.Get("notifications").([]any)
+	notifications := equinix_fabric_schema.NotificationsTerraformToGo(schemaNotifications)
+	request.SetNotifications(notifications)
+
+	if terraConfigProject, ok := d.GetOk("project"); ok {
+		project := equinix_fabric_schema.ProjectTerraformToGo(terraConfigProject.(*schema.Set).List())
+		request.SetProject(project)
+	}
+
+	priority := "PRIMARY"
+	if member == redundantConnectionSecondary {
+		priority = "SECONDARY"
+	}
+	redundancy := connectionRedundancyTerraformToGo([]any{map[string]any{
+		"priority": priority,
+		"group":    group,
+	}})
+	request.SetRedundancy(redundancy)
+
+	request.SetASide(connectionSideTerraformToGo(memberMap["a_side"].(*schema.Set).List()))
+	request.SetZSide(connectionSideTerraformToGo(memberMap["z_side"].(*schema.Set).List()))
+
+	return request
+}
+
+func resourceFabricRedundantConnectionCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
+	start := time.Now()
+
+	primaryRequest := redundantConnectionPostRequest(d, redundantConnectionPrimary, "")
+	primary, _, err := client.ConnectionsApi.CreateConnection(ctx).ConnectionPostRequest(primaryRequest).Execute()
+	if err != nil {
+		return diag.FromErr(equinix_errors.FormatFabricError(err))
+	}
+	primaryID := primary.GetUuid()
+
+	createTimeout := d.Timeout(schema.TimeoutCreate) - 30*time.Second - time.Since(start)
+	if err = waitUntilConnectionIsCreated(ctx, primaryID, meta, d, createTimeout); err != nil {
+		return rollbackRedundantConnection(ctx, d, meta, fmt.Errorf("error waiting for primary connection (%s) to be created: %s", primaryID, err), primaryID)
+	}
+	primary, _, err = client.ConnectionsApi.GetConnectionByUuid(ctx, primaryID).Execute()
+	if err != nil {
+		return rollbackRedundantConnection(ctx, d, meta, equinix_errors.FormatFabricError(err), primaryID)
+	}
+	redundancy := primary.GetRedundancy()
+	group := redundancy.GetGroup()
+	// The redundancy group is the ID of the pair and the group the secondary
+	// connection joins
+	if group == "" {
+		return rollbackRedundantConnection(ctx, d, meta, fmt.Errorf("primary connection (%s) has no redundancy group", primaryID), primaryID)
+	}
+
+	secondaryRequest := redundantConnectionPostRequest(d, redundantConnectionSecondary, group)
+	secondary, _, err := client.ConnectionsApi.CreateConnection(ctx).ConnectionPostRequest(secondaryRequest).Execute()
+	if err != nil {
+		return rollbackRedundantConnection(ctx, d, meta, equinix_errors.FormatFabricError(err), primaryID)
+	}
+	secondaryID := secondary.GetUuid()
+
+	createTimeout = d.Timeout(schema.TimeoutCreate) - 30*time.Second - time.Since(start)
+	if err = waitUntilConnectionIsCreated(ctx, secondaryID, meta, d, createTimeout); err != nil {
+		return rollbackRedundantConnection(ctx, d, meta, fmt.Errorf("error waiting for secondary connection (%s) to be created: %s", secondaryID, err), secondaryID, primaryID)
+	}
+
+	d.SetId(group)
+	if err := equinix_schema.SetMap(d, map[string]any{
+		"primary_connection_id":   primaryID,
+		"secondary_connection_id": secondaryID,
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceFabricRedundantConnectionRead(ctx, d, meta)
+}
+
+// rollbackRedundantConnection deletes the connections created before the
+// creation of the pair failed, for no connection to be left outside of state.
+// The deletion is bounded by the delete timeout rather than by the create
+// context, which may be the one that expired or got cancelled
+func rollbackRedundantConnection(ctx context.Context, d *schema.ResourceData, meta any, createErr error, uuids ...string) diag.Diagnostics {
+	diags := diag.FromErr(createErr)
+	rollbackCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+	start := time.Now()
+	for _, uuid := range uuids {
+		deleteTimeout := d.Timeout(schema.TimeoutDelete) - 30*time.Second - time.Since(start)
+		if err := deleteConnection(rollbackCtx, uuid, meta, d, deleteTimeout); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("error rolling back connection %s, it must be deleted manually", uuid),
+				Detail:   err.Error(),
+			})
+		}
+	}
+	return diags
+}
+
+func resourceFabricRedundantConnectionRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
+	members := map[string]*fabricv4.Connection{}
+	var diags diag.Diagnostics
+	for _, member := range []string{redundantConnectionPrimary, redundantConnectionSecondary} {
+		uuid := d.Get(member + "_connection_id").(string)
+		if uuid == "" {
+			continue
+		}
+		conn, httpResp, err := client.ConnectionsApi.GetConnectionByUuid(ctx, uuid).Execute()
+		if err == nil {
+			err = equinix_errors.CheckFabricDeletedState(uuid, string(conn.GetState()))
+		}
+		if err != nil {
+			if !equinix_errors.IsFabricNotFound(httpResp, err) {
+				return diag.FromErr(equinix_errors.FormatFabricError(err))
+			}
+			log.Printf("[WARN] %s connection %s of redundant connection %s not found: %s", member, uuid, d.Id(), err)
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("%s connection %s not found, the redundant connection is replaced on the next apply", member, uuid),
+				Detail:   equinix_errors.FormatFabricError(err).Error(),
+			})
+			continue
+		}
+		members[member] = conn
+	}
+	if len(members) == 0 {
+		log.Printf("[WARN] Connections of redundant connection %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// Sides are kept as configured, the ones reported by the API holding
+	// computed values that would otherwise replace the pair on every plan. A
+	// connection deleted outside of Terraform has its member and uuid cleared,
+	// for the next plan to replace the pair
+	remaining, ok := members[redundantConnectionPrimary]
+	if !ok {
+		remaining = members[redundantConnectionSecondary]
+	}
+	redundancy := remaining.GetRedundancy()
+	pair := map[string]any{
+		"bandwidth":        redundantConnectionBandwidth(d.Get("bandwidth").(int), members),
+		"redundancy_group": redundancy.GetGroup(),
+	}
+	for _, member := range []string{redundantConnectionPrimary, redundantConnectionSecondary} {
+		conn, ok := members[member]
+		if !ok {
+			pair[member] = []map[string]any{}
+			pair[member+"_connection_id"] = ""
+			continue
+		}
+		memberMap, ok := redundantConnectionMember(d.Get(member).([]any))
+		if !ok {
+			memberMap = map[string]any{}
+		}
+		memberMap["name"] = conn.GetName()
+		pair[member] = []map[string]any{memberMap}
+	}
+	if err := equinix_schema.SetMap(d, pair); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+// redundantConnectionBandwidth returns the bandwidth of the connection of the
+// pair that differs from the configured bandwidth, for drift on either
+// connection to show up, or the configured bandwidth when none differs
+func redundantConnectionBandwidth(configured int, members map[string]*fabricv4.Connection) int {
+	for _, member := range []string{redundantConnectionPrimary, redundantConnectionSecondary} {
+		if conn, ok := members[member]; ok && int(conn.GetBandwidth()) != configured {
+			return int(conn.GetBandwidth())
+		}
+	}
+	return configured
+}
+
+// connectionValueUpdate is a value to replace at a path of a connection
+type connectionValueUpdate struct {
+	path  string
+	value any
+}
+
+func resourceFabricRedundantConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	start := time.Now()
+	for _, member := range []string{redundantConnectionPrimary, redundantConnectionSecondary} {
+		uuid := d.Get(member + "_connection_id").(string)
+		var updates []connectionValueUpdate
+		if d.HasChange(member + ".0.name") {
+			updates = append(updates, connectionValueUpdate{"/name", d.Get(member + ".0.name").(string)})
+		}
+		if d.HasChange("bandwidth") {
+			updates = append(updates, connectionValueUpdate{"/bandwidth", d.Get("bandwidth").(int)})
+		}
+		for _, update := range updates {
+			updateTimeout := d.Timeout(schema.TimeoutUpdate) - 30*time.Second - time.Since(start)
+			if err := replaceConnectionValue(ctx, uuid, update.path, update.value, meta, d, updateTimeout); err != nil {
+				d.Partial(true)
+				return diag.Errorf("error updating %s connection: %v", member, err)
+			}
+		}
+	}
+	return resourceFabricRedundantConnectionRead(ctx, d, meta)
+}
+
+func resourceFabricRedundantConnectionDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	start := time.Now()
+	// The secondary connection is deleted first, for the primary connection to
+	// remain while the redundancy group has a member
+	for _, member := range []string{redundantConnectionSecondary, redundantConnectionPrimary} {
+		uuid := d.Get(member + "_connection_id").(string)
+		// A connection deleted outside of Terraform has no uuid left in state
+		if uuid == "" {
+			continue
+		}
+		deleteTimeout := d.Timeout(schema.TimeoutDelete) - 30*time.Second - time.Since(start)
+		if err := deleteConnection(ctx, uuid, meta, d, deleteTimeout); err != nil {
+			return diag.Errorf("error deleting %s connection: %v", member, err)
+		}
+	}
+	return nil
+}
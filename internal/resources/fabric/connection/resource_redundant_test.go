@@ -0,0 +1,206 @@
+package connection_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/equinix/terraform-provider-equinix/internal/acceptance"
+	testinghelpers "github.com/equinix/terraform-provider-equinix/internal/fabric/testing_helpers"
+	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/connection"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccFabricRedundantConnection_PFCR(t *testing.T) {
+	ports := testinghelpers.GetFabricEnvPorts(t)
+	connectionsTestData := testinghelpers.GetFabricEnvConnectionTestData(t)
+	var publicSPName, primaryPortUUID, secondaryPortUUID string
+	if len(ports) > 0 && len(connectionsTestData) > 0 {
+		publicSPName = connectionsTestData["pfcr"]["publicSPName"]
+		primaryPortUUID = ports["pfcr"]["dot1q"][0].GetUuid()
+		secondaryPortUUID = ports["pfcr"]["dot1q"][1].GetUuid()
+	}
+
+	primaryVlan, err := testinghelpers.RandomVlan(primaryPortUUID)
+	if err != nil {
+		t.Fatalf("unable to get a available VLAN: %s", err)
+		return
+	}
+
+	secondaryVlan, err := testinghelpers.RandomVlan(secondaryPortUUID)
+	if err != nil {
+		t.Fatalf("unable to get a available VLAN: %s", err)
+		return
+	}
+
+	variables := func(secondaryPortUUID string, bandwidth int) config.Variables {
+		return config.Variables{
+			"sp_name":             config.StringVariable(publicSPName),
+			"primary_port_uuid":   config.StringVariable(primaryPortUUID),
+			"primary_vlan":        config.IntegerVariable(primaryVlan),
+			"secondary_port_uuid": config.StringVariable(secondaryPortUUID),
+			"secondary_vlan":      config.IntegerVariable(secondaryVlan),
+			"bandwidth":           config.IntegerVariable(bandwidth),
+		}
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: CheckRedundantConnectionDelete,
+		Steps: []resource.TestStep{
+			{
+				Config:          redundantConnectionConfig,
+				ConfigVariables: variables(primaryPortUUID, 50),
+				PlanOnly:        true,
+				ExpectError:     regexp.MustCompile("the secondary connection must use a different port or virtual device"),
+			},
+			{
+				Config:          redundantConnectionConfig,
+				ConfigVariables: variables(secondaryPortUUID, 50),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("equinix_fabric_redundant_connection.test", tfjsonpath.New("primary_connection_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("equinix_fabric_redundant_connection.test", tfjsonpath.New("secondary_connection_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("equinix_fabric_redundant_connection.test", tfjsonpath.New("redundancy_group"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("equinix_fabric_redundant_connection.test", tfjsonpath.New("bandwidth"), knownvalue.Int64Exact(50)),
+				},
+			},
+			{
+				Config:          redundantConnectionConfig,
+				ConfigVariables: variables(secondaryPortUUID, 100),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("equinix_fabric_redundant_connection.test", tfjsonpath.New("bandwidth"), knownvalue.Int64Exact(100)),
+				},
+			},
+		},
+	})
+}
+
+func CheckRedundantConnectionDelete(s *terraform.State) error {
+	ctx := context.Background()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "equinix_fabric_redundant_connection" {
+			continue
+		}
+
+		for _, attribute := range []string{"primary_connection_id", "secondary_connection_id"} {
+			uuid := rs.Primary.Attributes[attribute]
+			err := connection.WaitUntilConnectionDeprovisioned(ctx, uuid, acceptance.TestAccProvider.Meta(), &schema.ResourceData{}, 10*time.Minute)
+			if err != nil {
+				return fmt.Errorf("API call failed while waiting for connection deletion. ID: %s, Err: %s", uuid, err)
+			}
+		}
+	}
+	return nil
+}
+
+var redundantConnectionConfig = `
+variable "sp_name" {
+  type = string
+}
+
+variable "primary_port_uuid" {
+  type = string
+}
+
+variable "primary_vlan" {
+  type = number
+}
+
+variable "secondary_port_uuid" {
+  type = string
+}
+
+variable "secondary_vlan" {
+  type = number
+}
+
+variable "bandwidth" {
+  type = number
+}
+
+data "equinix_fabric_service_profiles" "this" {
+  filter {
+    property = "/name"
+    operator = "="
+    values   = [var.sp_name]
+  }
+}
+
+resource "equinix_fabric_redundant_connection" "test" {
+  type      = "EVPL_VC"
+  bandwidth = var.bandwidth
+  notifications {
+    type   = "ALL"
+    emails = ["example@equinix.com"]
+  }
+  order {
+    purchase_order_number = "1-323292"
+  }
+
+  primary {
+    name = "redundant_pri_PFCR"
+    a_side {
+      access_point {
+        type = "COLO"
+        port {
+          uuid = var.primary_port_uuid
+        }
+        link_protocol {
+          type     = "DOT1Q"
+          vlan_tag = var.primary_vlan
+        }
+      }
+    }
+    z_side {
+      access_point {
+        type = "SP"
+        profile {
+          type = "L2_PROFILE"
+          uuid = data.equinix_fabric_service_profiles.this.data.0.uuid
+        }
+        location {
+          metro_code = "DC"
+        }
+      }
+    }
+  }
+
+  secondary {
+    name = "redundant_sec_PFCR"
+    a_side {
+      access_point {
+        type = "COLO"
+        port {
+          uuid = var.secondary_port_uuid
+        }
+        link_protocol {
+          type     = "DOT1Q"
+          vlan_tag = var.secondary_vlan
+        }
+      }
+    }
+    z_side {
+      access_point {
+        type = "SP"
+        profile {
+          type = "L2_PROFILE"
+          uuid = data.equinix_fabric_service_profiles.this.data.0.uuid
+        }
+        location {
+          metro_code = "DC"
+        }
+      }
+    }
+  }
+}
+`
@@ -1,6 +1,8 @@
 package connection
 
 import (
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/equinix/terraform-provider-equinix/internal/converters"
 	equinix_fabric_schema "github.com/equinix/terraform-provider-equinix/internal/fabric/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -43,6 +45,19 @@ func fabricConnectionResourceSchema() map[string]*schema.Schema {
 			Required:    true,
 			Description: "Connection bandwidth in Mbps",
 		},
+		"bandwidth_schedule": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Time windows in which the connection bandwidth differs from bandwidth. The connection bandwidth only changes on apply, an apply is needed after the start and after the end of each window",
+			Elem: &schema.Resource{
+				Schema: connectionBandwidthScheduleSch(),
+			},
+		},
+		"effective_bandwidth": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Connection bandwidth in Mbps currently provisioned, following bandwidth_schedule when set",
+		},
 		"geo_scope": {
 			Type:         schema.TypeString,
 			Optional:     true,
@@ -83,6 +98,17 @@ func fabricConnectionResourceSchema() map[string]*schema.Schema {
 				Schema: equinix_fabric_schema.ProjectSch(),
 			},
 		},
+		"marketplace_subscription": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Equinix Fabric Entity for Marketplace Subscription. The subscription must be ACTIVE and have quantity available in its entitlements",
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: connectionMarketplaceSubscriptionSch(),
+			},
+		},
 		"additional_info": {
 			Type:        schema.TypeList,
 			Optional:    true,
@@ -601,6 +627,46 @@ func connectionRedundancySch() map[string]*schema.Schema {
 	}
 }
 
+func connectionBandwidthScheduleSch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"start_date_time": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+			Description:  "Start of the time window, in RFC 3339 format; 2025-01-31T18:00:00Z",
+		},
+		"end_date_time": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+			Description:  "End of the time window, in RFC 3339 format; 2025-01-31T23:00:00Z",
+		},
+		"bandwidth": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Connection bandwidth in Mbps during the time window",
+		},
+	}
+}
+
+func connectionMarketplaceSubscriptionSch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"type": {
+			Type:         schema.TypeString,
+			Computed:     true,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(converters.EnumArrayToStringArray(fabricv4.AllowedMarketplaceSubscriptionTypeEnumValues), false),
+			Description:  "Marketplace Subscription type like; AWS_MARKETPLACE_SUBSCRIPTION",
+		},
+		"uuid": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Equinix-assigned Marketplace Subscription identifier",
+		},
+	}
+}
+
 func createAPIConfigSch() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"api_available": {
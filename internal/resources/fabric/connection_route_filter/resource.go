@@ -3,11 +3,11 @@ package connection_route_filter
 import (
 	"context"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/equinix/terraform-provider-equinix/internal/config"
 	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/importer"
 
 	"github.com/equinix/equinix-sdk-go/services/fabricv4"
 
@@ -30,7 +30,7 @@ func Resource() *schema.Resource {
 		UpdateContext: resourceUpdate,
 		DeleteContext: resourceDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importer.StateContext("connection_id"),
 		},
 		Schema: resourceSchema(),
 		Description: `Fabric V4 API compatible resource allows attachment of Route Filter Polices to Fabric Connections
@@ -44,13 +44,9 @@ Additional Documentation:
 func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
 	connectionID := d.Get("connection_id").(string)
-	connectionRouteFilter, _, err := client.RouteFiltersApi.GetConnectionRouteFilterByUuid(ctx, d.Id(), connectionID).Execute()
+	connectionRouteFilter, httpResp, err := client.RouteFiltersApi.GetConnectionRouteFilterByUuid(ctx, d.Id(), connectionID).Execute()
 	if err != nil {
-		log.Printf("[WARN] Route Filter Policy %s not found on Connection %s, error %s", d.Id(), connectionID, err)
-		if !strings.Contains(err.Error(), "500") {
-			d.SetId("")
-		}
-		return diag.FromErr(equinix_errors.FormatFabricError(err))
+		return equinix_errors.FabricReadDiagnostics(d, httpResp, err)
 	}
 	d.SetId(connectionRouteFilter.GetUuid())
 	return setConnectionRouteFilterMap(d, connectionRouteFilter)
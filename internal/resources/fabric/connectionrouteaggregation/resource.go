@@ -18,7 +18,9 @@ func NewResource() resource.Resource {
 	return &Resource{
 		BaseResource: framework.NewBaseResource(
 			framework.BaseResourceConfig{
-				Name: "equinix_fabric_connection_route_aggregation",
+				Name:          "equinix_fabric_connection_route_aggregation",
+				IDAttr:        "route_aggregation_id",
+				ParentIDAttrs: []string{"connection_id"},
 			},
 		),
 	}
@@ -77,6 +79,7 @@ func (r *Resource) Create(
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -98,8 +101,13 @@ func (r *Resource) Read(
 	routeAggregationID := state.RouteAggregationID.ValueString()
 	connectionID := state.ConnectionID.ValueString()
 
-	connectionRouteAggregation, _, err := client.RouteAggregationsApi.GetConnectionRouteAggregationByUuid(ctx, routeAggregationID, connectionID).Execute()
+	connectionRouteAggregation, httpResp, err := client.RouteAggregationsApi.GetConnectionRouteAggregationByUuid(ctx, routeAggregationID, connectionID).Execute()
 	if err != nil {
+		if equinix_errors.IsFabricNotFound(httpResp, err) {
+			resp.Diagnostics.Append(equinix_errors.NewResourceNotFoundWarningDiagnostic(err))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed retrieving Connection Route Aggregation Attachment %s", id), equinix_errors.FormatFabricError(err).Error())
 		return
@@ -112,6 +120,7 @@ func (r *Resource) Read(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *Resource) Delete(
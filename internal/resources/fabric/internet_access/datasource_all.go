@@ -0,0 +1,108 @@
+// Package internetaccess for Fabric Internet Access service resource and data sources
+package internetaccess
+
+import (
+	"context"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// NewDataSourceAllServices creates a new data source searching Internet Access services
+func NewDataSourceAllServices() datasource.DataSource {
+	return &DataSourceAllServices{
+		BaseDataSource: framework.NewBaseDataSource(
+			framework.BaseDataSourceConfig{
+				Name: "equinix_fabric_internet_access_services",
+			},
+		),
+	}
+}
+
+// DataSourceAllServices represents Internet Access services search data source
+type DataSourceAllServices struct {
+	framework.BaseDataSource
+}
+
+// Schema returns the data source schema
+func (r *DataSourceAllServices) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = dataSourceAllSchema(ctx)
+}
+
+// Read searches the Internet Access services matching the filters
+func (r *DataSourceAllServices) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	client := r.Meta.NewFabricClientForFramework(ctx, request.ProviderMeta)
+
+	var data dataSourceAllModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	searchRequest, diags := buildSearchRequest(ctx, data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	services, _, err := client.InternetAccessServicesApi.SearchEiaServices(ctx).InternetAccessSearchRequest(searchRequest).Execute()
+	if err != nil {
+		response.Diagnostics.AddError("api error retrieving internet access services data", equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	response.Diagnostics.Append(data.parse(ctx, services)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func buildSearchRequest(ctx context.Context, data dataSourceAllModel) (fabricv4.InternetAccessSearchRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	searchRequest := fabricv4.InternetAccessSearchRequest{}
+
+	var offset, limit int32 = 0, 20
+	if isKnown(data.Pagination) {
+		pagination, diags := data.Pagination.ToPtr(ctx)
+		if diags.HasError() {
+			return fabricv4.InternetAccessSearchRequest{}, diags
+		}
+		offset = pagination.Offset.ValueInt32()
+		if pagination.Limit.ValueInt32() != 0 {
+			limit = pagination.Limit.ValueInt32()
+		}
+	}
+	searchRequest.SetPagination(fabricv4.PaginationRequest{Offset: &offset, Limit: &limit})
+
+	if isKnown(data.Filters) {
+		filters, diags := data.Filters.ToSlice(ctx)
+		if diags.HasError() {
+			return fabricv4.InternetAccessSearchRequest{}, diags
+		}
+		expressions := make([]fabricv4.SearchExpression, len(filters))
+		for i, filter := range filters {
+			var values []string
+			diags.Append(filter.Values.ElementsAs(ctx, &values, false)...)
+			if diags.HasError() {
+				return fabricv4.InternetAccessSearchRequest{}, diags
+			}
+			expressions[i].SetProperty(filter.Property.ValueString())
+			expressions[i].SetOperator(fabricv4.SearchExpressionOperator(filter.Operator.ValueString()))
+			expressions[i].SetValues(values)
+		}
+		searchRequest.SetFilter(fabricv4.SearchExpression{And: expressions})
+	}
+
+	return searchRequest, diags
+}
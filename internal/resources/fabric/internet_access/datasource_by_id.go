@@ -0,0 +1,60 @@
+// Package internetaccess for Fabric Internet Access service resource and data sources
+package internetaccess
+
+import (
+	"context"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// NewDataSourceByServiceID creates a new data source retrieving an Internet Access service by its uuid
+func NewDataSourceByServiceID() datasource.DataSource {
+	return &DataSourceByServiceID{
+		BaseDataSource: framework.NewBaseDataSource(
+			framework.BaseDataSourceConfig{
+				Name: "equinix_fabric_internet_access_service",
+			},
+		),
+	}
+}
+
+// DataSourceByServiceID represents Internet Access service data source by uuid
+type DataSourceByServiceID struct {
+	framework.BaseDataSource
+}
+
+// Schema returns the data source schema
+func (r *DataSourceByServiceID) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = dataSourceByIDSchema(ctx)
+}
+
+// Read retrieves the Internet Access service
+func (r *DataSourceByServiceID) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	client := r.Meta.NewFabricClientForFramework(ctx, request.ProviderMeta)
+
+	var data dataSourceByIDModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	service, _, err := client.InternetAccessServicesApi.GetEiaService(ctx, data.ServiceID.ValueString()).Execute()
+	if err != nil {
+		response.Diagnostics.AddError("api error retrieving internet access service data", equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	response.Diagnostics.Append(data.parse(ctx, service)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,360 @@
+// Package internetaccess for Fabric Internet Access service resource and data sources
+package internetaccess
+
+import (
+	"context"
+
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func dataSourceByIDSchema(ctx context.Context) schema.Schema {
+	serviceSchema := getServiceSchema(ctx)
+	serviceSchema["id"] = framework.IDAttributeDefaultDescription()
+	serviceSchema["service_id"] = schema.StringAttribute{
+		Description: "The uuid of the Internet Access service this data source should retrieve",
+		Required:    true,
+	}
+	return schema.Schema{
+		Description: `Fabric V4 API compatible data resource that allow user to fetch Equinix Fabric Internet Access service by UUID
+
+Additional Documentation:
+* Getting Started: https://docs.equinix.com/internet-access/
+* API: https://docs.equinix.com/api-catalog/fabricv4/#tag/Internet-Access-Services`,
+		Attributes: serviceSchema,
+	}
+}
+
+func dataSourceAllSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: `Fabric V4 API compatible data resource that allow user to fetch Equinix Fabric Internet Access services matching custom search criteria
+
+Additional Documentation:
+* Getting Started: https://docs.equinix.com/internet-access/
+* API: https://docs.equinix.com/api-catalog/fabricv4/#tag/Internet-Access-Services`,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttributeDefaultDescription(),
+			"filters": schema.ListNestedAttribute{
+				Description: "List of filters to apply to the Internet Access services search request. All will be AND'd together",
+				Optional:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[filterModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(8),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"property": schema.StringAttribute{
+							Description: "Property to apply the filter to; /name, /uuid, /state, /type, /project/projectId",
+							Required:    true,
+						},
+						"operator": schema.StringAttribute{
+							Description: "Operation applied to the values of the filter; =, !=, >, >=, <, <=, BETWEEN, NOT BETWEEN, LIKE, NOT LIKE, IN, NOT IN, IS NOT NULL, IS NULL",
+							Required:    true,
+						},
+						"values": schema.ListAttribute{
+							Description: "List of values to apply the operation to for the specified property",
+							Required:    true,
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"pagination": schema.SingleNestedAttribute{
+				Description: "Pagination details for the returned Internet Access services list",
+				Optional:    true,
+				Computed:    true,
+				CustomType:  fwtypes.NewObjectTypeOf[paginationModel](ctx),
+				Attributes: map[string]schema.Attribute{
+					"offset": schema.Int32Attribute{
+						Description: "Index of the first item returned in the response. The default is 0",
+						Optional:    true,
+						Computed:    true,
+					},
+					"limit": schema.Int32Attribute{
+						Description: "Maximum number of search results returned per page. Number must be between 1 and 100, and the default is 20",
+						Optional:    true,
+						Computed:    true,
+					},
+					"total": schema.Int32Attribute{
+						Description: "The total number of Internet Access services matching the filters",
+						Computed:    true,
+					},
+					"next": schema.StringAttribute{
+						Description: "The URL relative to the next item in the response",
+						Computed:    true,
+					},
+					"previous": schema.StringAttribute{
+						Description: "The URL relative to the previous item in the response",
+						Computed:    true,
+					},
+				},
+			},
+			"data": schema.ListNestedAttribute{
+				Description: "Returned list of Internet Access services",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[baseModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: getServiceSchema(ctx),
+				},
+			},
+		},
+	}
+}
+
+func getServiceSchema(ctx context.Context) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"type": schema.StringAttribute{
+			Description: "Internet Access service type",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Customer-provided name of the Internet Access service",
+			Computed:    true,
+		},
+		"bandwidth": schema.Int32Attribute{
+			Description: "Bandwidth of the Internet Access service in Mbps",
+			Computed:    true,
+		},
+		"bandwidth_commit": schema.Int32Attribute{
+			Description: "Minimum bandwidth commit in Mbps, for services billed with the BURST_BASED billing type",
+			Computed:    true,
+		},
+		"billing": schema.SingleNestedAttribute{
+			Description: "Billing details of the Internet Access service",
+			Computed:    true,
+			CustomType:  fwtypes.NewObjectTypeOf[billingModel](ctx),
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Description: "Billing type of the Internet Access service",
+					Computed:    true,
+				},
+				"enabled": schema.BoolAttribute{
+					Description: "Boolean value indicating whether the billing of the service is enabled",
+					Computed:    true,
+				},
+				"start_date": schema.StringAttribute{
+					Description: "Start date of the billing period of the service",
+					Computed:    true,
+				},
+			},
+		},
+		"project": schema.SingleNestedAttribute{
+			Description: "Equinix Project attribute object",
+			Computed:    true,
+			CustomType:  fwtypes.NewObjectTypeOf[projectModel](ctx),
+			Attributes: map[string]schema.Attribute{
+				"project_id": schema.StringAttribute{
+					Description: "Equinix Subscriber-assigned project ID",
+					Computed:    true,
+				},
+			},
+		},
+		"account": schema.SingleNestedAttribute{
+			Description: "Equinix account the Internet Access service is billed to",
+			Computed:    true,
+			CustomType:  fwtypes.NewObjectTypeOf[accountModel](ctx),
+			Attributes: map[string]schema.Attribute{
+				"account_number": schema.StringAttribute{
+					Description: "Equinix account number",
+					Computed:    true,
+				},
+			},
+		},
+		"order": schema.SingleNestedAttribute{
+			Description: "Order details of the Internet Access service",
+			Computed:    true,
+			CustomType:  fwtypes.NewObjectTypeOf[orderModel](ctx),
+			Attributes: map[string]schema.Attribute{
+				"purchase_order_number": schema.StringAttribute{
+					Description: "Purchase order number",
+					Computed:    true,
+				},
+				"customer_reference_number": schema.StringAttribute{
+					Description: "Customer reference number",
+					Computed:    true,
+				},
+				"billing_tier": schema.StringAttribute{
+					Description: "Billing tier of the service bandwidth",
+					Computed:    true,
+				},
+				"order_id": schema.StringAttribute{
+					Description: "Order identifier",
+					Computed:    true,
+				},
+				"order_number": schema.StringAttribute{
+					Description: "Order reference number",
+					Computed:    true,
+				},
+				"term_length": schema.Int32Attribute{
+					Description: "Term length in months",
+					Computed:    true,
+				},
+				"contracted_bandwidth": schema.Int32Attribute{
+					Description: "Contracted bandwidth in Mbps",
+					Computed:    true,
+				},
+			},
+		},
+		"routing_protocol": schema.SingleNestedAttribute{
+			Description: "Routing protocol of the Internet Access service and the Fabric connections it is delivered over",
+			Computed:    true,
+			CustomType:  fwtypes.NewObjectTypeOf[routingProtocolModel](ctx),
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Description: "Routing protocol type",
+					Computed:    true,
+				},
+				"ip_block_uuids": schema.ListAttribute{
+					Description: "UUIDs of the Fabric IP blocks advertised as customer routes of the service",
+					Computed:    true,
+					CustomType:  fwtypes.ListOfStringType,
+					ElementType: types.StringType,
+				},
+				"connections": schema.ListNestedAttribute{
+					Description: "Fabric connections the Internet Access service is delivered over",
+					Computed:    true,
+					CustomType:  fwtypes.NewListNestedObjectTypeOf[connectionModel](ctx),
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"uuid": schema.StringAttribute{
+								Description: "Equinix-assigned connection identifier",
+								Computed:    true,
+							},
+							"href": schema.StringAttribute{
+								Description: "Connection URI",
+								Computed:    true,
+							},
+							"peering_ipv4": dataSourcePeeringSchema(ctx, "IPv4"),
+							"peering_ipv6": dataSourcePeeringSchema(ctx, "IPv6"),
+						},
+					},
+				},
+				"export_policy": schema.StringAttribute{
+					Description: "Routes exported to the customer over BGP",
+					Computed:    true,
+				},
+				"customer_asn": schema.Int64Attribute{
+					Description: "Customer ASN for BGP peering",
+					Computed:    true,
+				},
+				"customer_asn_range": schema.StringAttribute{
+					Description: "Range of the customer ASN for BGP peering",
+					Computed:    true,
+				},
+				"bgp_auth_key": schema.StringAttribute{
+					Description: "BGP authentication key",
+					Computed:    true,
+					Sensitive:   true,
+				},
+			},
+		},
+		"href": schema.StringAttribute{
+			Description: "Internet Access service URI",
+			Computed:    true,
+		},
+		"uuid": schema.StringAttribute{
+			Description: "Equinix-assigned unique identifier of the Internet Access service",
+			Computed:    true,
+		},
+		"state": schema.StringAttribute{
+			Description: "Value representing provisioning status of the Internet Access service",
+			Computed:    true,
+		},
+		"use_case": schema.StringAttribute{
+			Description: "Use case of the Internet Access service",
+			Computed:    true,
+		},
+		"locations": schema.ListNestedAttribute{
+			Description: "Locations the Internet Access service is delivered in",
+			Computed:    true,
+			CustomType:  fwtypes.NewListNestedObjectTypeOf[locationModel](ctx),
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"metro_code": schema.StringAttribute{
+						Description: "Metro code",
+						Computed:    true,
+					},
+					"region": schema.StringAttribute{
+						Description: "Region of the metro",
+						Computed:    true,
+					},
+					"ibx": schema.StringAttribute{
+						Description: "IBX data center code",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"change_log": schema.SingleNestedAttribute{
+			Description: "Details of the last change on the Internet Access service",
+			Computed:    true,
+			CustomType:  fwtypes.NewObjectTypeOf[changeLogModel](ctx),
+			Attributes: map[string]schema.Attribute{
+				"created_by": schema.StringAttribute{
+					Description: "User name of creator of the Internet Access service",
+					Computed:    true,
+				},
+				"created_date_time": schema.StringAttribute{
+					Description: "Creation time of the Internet Access service",
+					Computed:    true,
+				},
+				"updated_by": schema.StringAttribute{
+					Description: "User name of last updater of the Internet Access service",
+					Computed:    true,
+				},
+				"updated_date_time": schema.StringAttribute{
+					Description: "Last update time of the Internet Access service",
+					Computed:    true,
+				},
+				"deleted_by": schema.StringAttribute{
+					Description: "User name of deleter of the Internet Access service",
+					Computed:    true,
+				},
+				"deleted_date_time": schema.StringAttribute{
+					Description: "Deletion time of the Internet Access service",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePeeringSchema(ctx context.Context, version string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: version + " peering details of the connection",
+		Computed:    true,
+		CustomType:  fwtypes.NewObjectTypeOf[peeringModel](ctx),
+		Attributes: map[string]schema.Attribute{
+			"prefix": schema.StringAttribute{
+				Description: version + " prefix of the peering",
+				Computed:    true,
+			},
+			"prefix_length": schema.Int32Attribute{
+				Description: "Size of the peering subnet",
+				Computed:    true,
+			},
+			"equinix_peer_ip": schema.StringAttribute{
+				Description: version + " peering IP address of the Equinix side",
+				Computed:    true,
+			},
+			"customer_peer_ip": schema.StringAttribute{
+				Description: version + " peering IP address of the customer side",
+				Computed:    true,
+			},
+			"equinix_vrrp_ip": schema.StringAttribute{
+				Description: version + " VRRP IP address of the Equinix side",
+				Computed:    true,
+			},
+			"customer_vrrp_ip": schema.StringAttribute{
+				Description: version + " VRRP IP address of the customer side",
+				Computed:    true,
+			},
+		},
+	}
+}
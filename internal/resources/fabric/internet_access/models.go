@@ -0,0 +1,415 @@
+// Package internetaccess for Fabric Internet Access service resource and data sources
+package internetaccess
+
+import (
+	"context"
+	"slices"
+
+	"github.com/equinix/terraform-provider-equinix/internal/fabric"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type resourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	baseModel
+}
+
+type dataSourceByIDModel struct {
+	ID        types.String `tfsdk:"id"`
+	ServiceID types.String `tfsdk:"service_id"`
+	baseModel
+}
+
+type dataSourceAllModel struct {
+	ID         types.String                                 `tfsdk:"id"`
+	Filters    fwtypes.ListNestedObjectValueOf[filterModel] `tfsdk:"filters"`
+	Pagination fwtypes.ObjectValueOf[paginationModel]       `tfsdk:"pagination"`
+	Data       fwtypes.ListNestedObjectValueOf[baseModel]   `tfsdk:"data"`
+}
+
+type filterModel struct {
+	Property types.String                      `tfsdk:"property"`
+	Operator types.String                      `tfsdk:"operator"`
+	Values   fwtypes.ListValueOf[types.String] `tfsdk:"values"`
+}
+
+type paginationModel struct {
+	Offset   types.Int32  `tfsdk:"offset"`
+	Limit    types.Int32  `tfsdk:"limit"`
+	Total    types.Int32  `tfsdk:"total"`
+	Next     types.String `tfsdk:"next"`
+	Previous types.String `tfsdk:"previous"`
+}
+
+type baseModel struct {
+	Type            types.String                                   `tfsdk:"type"`
+	Name            types.String                                   `tfsdk:"name"`
+	Bandwidth       types.Int32                                    `tfsdk:"bandwidth"`
+	BandwidthCommit types.Int32                                    `tfsdk:"bandwidth_commit"`
+	Billing         fwtypes.ObjectValueOf[billingModel]            `tfsdk:"billing"`
+	Project         fwtypes.ObjectValueOf[projectModel]            `tfsdk:"project"`
+	Account         fwtypes.ObjectValueOf[accountModel]            `tfsdk:"account"`
+	Order           fwtypes.ObjectValueOf[orderModel]              `tfsdk:"order"`
+	RoutingProtocol fwtypes.ObjectValueOf[routingProtocolModel]    `tfsdk:"routing_protocol"`
+	Href            types.String                                   `tfsdk:"href"`
+	UUID            types.String                                   `tfsdk:"uuid"`
+	State           types.String                                   `tfsdk:"state"`
+	UseCase         types.String                                   `tfsdk:"use_case"`
+	Locations       fwtypes.ListNestedObjectValueOf[locationModel] `tfsdk:"locations"`
+	ChangeLog       fwtypes.ObjectValueOf[changeLogModel]          `tfsdk:"change_log"`
+}
+
+type billingModel struct {
+	Type      types.String `tfsdk:"type"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	StartDate types.String `tfsdk:"start_date"`
+}
+
+type projectModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+}
+
+type accountModel struct {
+	AccountNumber types.String `tfsdk:"account_number"`
+}
+
+type orderModel struct {
+	PurchaseOrderNumber     types.String `tfsdk:"purchase_order_number"`
+	CustomerReferenceNumber types.String `tfsdk:"customer_reference_number"`
+	BillingTier             types.String `tfsdk:"billing_tier"`
+	OrderID                 types.String `tfsdk:"order_id"`
+	OrderNumber             types.String `tfsdk:"order_number"`
+	TermLength              types.Int32  `tfsdk:"term_length"`
+	ContractedBandwidth     types.Int32  `tfsdk:"contracted_bandwidth"`
+}
+
+type routingProtocolModel struct {
+	Type             types.String                                     `tfsdk:"type"`
+	IPBlockUUIDs     fwtypes.ListValueOf[types.String]                `tfsdk:"ip_block_uuids"`
+	Connections      fwtypes.ListNestedObjectValueOf[connectionModel] `tfsdk:"connections"`
+	ExportPolicy     types.String                                     `tfsdk:"export_policy"`
+	CustomerAsn      types.Int64                                      `tfsdk:"customer_asn"`
+	CustomerAsnRange types.String                                     `tfsdk:"customer_asn_range"`
+	BgpAuthKey       types.String                                     `tfsdk:"bgp_auth_key"`
+}
+
+type connectionModel struct {
+	UUID        types.String                        `tfsdk:"uuid"`
+	Href        types.String                        `tfsdk:"href"`
+	PeeringIpv4 fwtypes.ObjectValueOf[peeringModel] `tfsdk:"peering_ipv4"`
+	PeeringIpv6 fwtypes.ObjectValueOf[peeringModel] `tfsdk:"peering_ipv6"`
+}
+
+type peeringModel struct {
+	Prefix         types.String `tfsdk:"prefix"`
+	PrefixLength   types.Int32  `tfsdk:"prefix_length"`
+	EquinixPeerIP  types.String `tfsdk:"equinix_peer_ip"`
+	CustomerPeerIP types.String `tfsdk:"customer_peer_ip"`
+	EquinixVrrpIP  types.String `tfsdk:"equinix_vrrp_ip"`
+	CustomerVrrpIP types.String `tfsdk:"customer_vrrp_ip"`
+}
+
+type locationModel struct {
+	MetroCode types.String `tfsdk:"metro_code"`
+	Region    types.String `tfsdk:"region"`
+	Ibx       types.String `tfsdk:"ibx"`
+}
+
+type changeLogModel struct {
+	CreatedBy       types.String `tfsdk:"created_by"`
+	CreatedDateTime types.String `tfsdk:"created_date_time"`
+	UpdatedBy       types.String `tfsdk:"updated_by"`
+	UpdatedDateTime types.String `tfsdk:"updated_date_time"`
+	DeletedBy       types.String `tfsdk:"deleted_by"`
+	DeletedDateTime types.String `tfsdk:"deleted_date_time"`
+}
+
+func (m *resourceModel) parse(ctx context.Context, service *fabricv4.InternetAccessService) diag.Diagnostics {
+	m.ID = types.StringValue(service.GetUuid())
+	return m.baseModel.parse(ctx, service)
+}
+
+func (m *dataSourceByIDModel) parse(ctx context.Context, service *fabricv4.InternetAccessService) diag.Diagnostics {
+	m.ID = types.StringValue(service.GetUuid())
+	m.ServiceID = types.StringValue(service.GetUuid())
+	return m.baseModel.parse(ctx, service)
+}
+
+func (m *dataSourceAllModel) parse(ctx context.Context, services *fabricv4.InternetAccessServices) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(services.GetData()) < 1 {
+		diags.AddError("no data retrieved by internet access services data source",
+			"either the account does not have any internet access services matching the filters or the combination of limit and offset needs to be updated")
+		return diags
+	}
+
+	data := make([]baseModel, len(services.GetData()))
+	for i, service := range services.GetData() {
+		diags.Append(data[i].parse(ctx, &service)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	responsePagination := services.GetPagination()
+	pagination := paginationModel{
+		Offset:   types.Int32Value(responsePagination.GetOffset()),
+		Limit:    types.Int32Value(responsePagination.GetLimit()),
+		Total:    types.Int32Value(responsePagination.GetTotal()),
+		Next:     types.StringValue(responsePagination.GetNext()),
+		Previous: types.StringValue(responsePagination.GetPrevious()),
+	}
+
+	m.ID = types.StringValue(data[0].UUID.ValueString())
+	m.Pagination = fwtypes.NewObjectValueOf[paginationModel](ctx, &pagination)
+	m.Data = fwtypes.NewListNestedObjectValueOfValueSlice[baseModel](ctx, data)
+	return diags
+}
+
+func (m *baseModel) parse(ctx context.Context, service *fabricv4.InternetAccessService) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Type = types.StringValue(string(service.GetType()))
+	m.Name = types.StringValue(service.GetName())
+	m.Bandwidth = types.Int32PointerValue(service.Bandwidth)
+	m.BandwidthCommit = types.Int32PointerValue(service.BandwidthCommit)
+	m.Href = types.StringValue(service.GetHref())
+	m.UUID = types.StringValue(service.GetUuid())
+	m.State = types.StringValue(string(service.GetState()))
+	m.UseCase = types.StringValue(string(service.GetUseCase()))
+
+	billing := service.GetBilling()
+	m.Billing = fwtypes.NewObjectValueOf[billingModel](ctx, &billingModel{
+		Type:      types.StringValue(string(billing.GetType())),
+		Enabled:   types.BoolValue(billing.GetEnabled()),
+		StartDate: types.StringValue(fabric.FormatOptionalTime(billing.StartDate)),
+	})
+
+	project := service.GetProject()
+	m.Project = fwtypes.NewObjectValueOf[projectModel](ctx, &projectModel{
+		ProjectID: types.StringValue(project.GetProjectId()),
+	})
+
+	account := service.GetAccount()
+	m.Account = fwtypes.NewObjectValueOf[accountModel](ctx, &accountModel{
+		AccountNumber: types.StringValue(account.GetAccountNumber()),
+	})
+
+	order := service.GetOrder()
+	purchaseOrderNumber := types.StringNull()
+	if order.GetPurchaseOrderNumber() != "" {
+		purchaseOrderNumber = types.StringValue(order.GetPurchaseOrderNumber())
+	}
+	m.Order = fwtypes.NewObjectValueOf[orderModel](ctx, &orderModel{
+		PurchaseOrderNumber:     purchaseOrderNumber,
+		CustomerReferenceNumber: types.StringValue(order.GetCustomerReferenceNumber()),
+		BillingTier:             types.StringValue(order.GetBillingTier()),
+		OrderID:                 types.StringValue(order.GetOrderId()),
+		OrderNumber:             types.StringValue(order.GetOrderNumber()),
+		TermLength:              types.Int32Value(order.GetTermLength()),
+		ContractedBandwidth:     types.Int32Value(order.GetContractedBandwidth()),
+	})
+
+	routingProtocol, rpDiags := m.parseRoutingProtocol(ctx, service.GetRoutingProtocol())
+	diags.Append(rpDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	m.RoutingProtocol = fwtypes.NewObjectValueOf[routingProtocolModel](ctx, routingProtocol)
+
+	locations := make([]locationModel, len(service.GetLocations()))
+	for i, location := range service.GetLocations() {
+		locations[i] = locationModel{
+			MetroCode: types.StringValue(location.GetMetroCode()),
+			Region:    types.StringValue(string(location.GetRegion())),
+			Ibx:       types.StringValue(location.GetIbx()),
+		}
+	}
+	m.Locations = fwtypes.NewListNestedObjectValueOfValueSlice[locationModel](ctx, locations)
+
+	changeLog := service.GetChangeLog()
+	m.ChangeLog = fwtypes.NewObjectValueOf[changeLogModel](ctx, &changeLogModel{
+		CreatedBy:       types.StringValue(changeLog.GetCreatedBy()),
+		CreatedDateTime: types.StringValue(changeLog.GetCreatedDateTime().Format(fabric.TimeFormat)),
+		UpdatedBy:       types.StringValue(changeLog.GetUpdatedBy()),
+		UpdatedDateTime: types.StringValue(changeLog.GetUpdatedDateTime().Format(fabric.TimeFormat)),
+		DeletedBy:       types.StringValue(changeLog.GetDeletedBy()),
+		DeletedDateTime: types.StringValue(changeLog.GetDeletedDateTime().Format(fabric.TimeFormat)),
+	})
+
+	return diags
+}
+
+// parseRoutingProtocol parses the routing protocol of the service. The BGP
+// details are only available as additional properties of the routing protocol,
+// and the BGP authentication key is kept from the prior model as it is not
+// returned by the API
+func (m *baseModel) parseRoutingProtocol(ctx context.Context, routingProtocol fabricv4.InternetAccessRoutingProtocol) (*routingProtocolModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	prior := &routingProtocolModel{}
+	if !m.RoutingProtocol.IsNull() && !m.RoutingProtocol.IsUnknown() {
+		prior, diags = m.RoutingProtocol.ToPtr(ctx)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	ipBlockUUIDs := make([]string, len(routingProtocol.GetCustomerRoutes()))
+	for i, route := range routingProtocol.GetCustomerRoutes() {
+		ipBlock := route.GetIpBlock()
+		ipBlockUUIDs[i] = ipBlock.GetUuid()
+	}
+	var priorIPBlockUUIDs []string
+	if !prior.IPBlockUUIDs.IsNull() && !prior.IPBlockUUIDs.IsUnknown() {
+		diags.Append(prior.IPBlockUUIDs.ElementsAs(ctx, &priorIPBlockUUIDs, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+	// Keep the configured order of the IP blocks when they did not change
+	if sameElements(ipBlockUUIDs, priorIPBlockUUIDs) {
+		ipBlockUUIDs = priorIPBlockUUIDs
+	}
+	ipBlockValues := make([]attr.Value, len(ipBlockUUIDs))
+	for i, uuid := range ipBlockUUIDs {
+		ipBlockValues[i] = types.StringValue(uuid)
+	}
+	ipBlocks, listDiags := fwtypes.NewListValueOf[types.String](ctx, ipBlockValues)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	connections := make([]connectionModel, len(routingProtocol.GetConnections()))
+	for i, connection := range routingProtocol.GetConnections() {
+		connections[i] = connectionModel{
+			UUID:        types.StringValue(connection.GetUuid()),
+			Href:        types.StringValue(connection.GetHref()),
+			PeeringIpv4: fwtypes.NewObjectValueOfNull[peeringModel](ctx),
+			PeeringIpv6: fwtypes.NewObjectValueOfNull[peeringModel](ctx),
+		}
+		if peering, ok := connection.GetPeeringIpv4Ok(); ok {
+			connections[i].PeeringIpv4 = fwtypes.NewObjectValueOf[peeringModel](ctx, &peeringModel{
+				Prefix:         types.StringValue(peering.GetPrefix()),
+				PrefixLength:   types.Int32Value(peering.GetPrefixLength()),
+				EquinixPeerIP:  types.StringValue(peering.GetEquinixPeerIp()),
+				CustomerPeerIP: types.StringValue(peering.GetCustomerPeerIp()),
+				EquinixVrrpIP:  types.StringValue(peering.GetEquinixVrrpIp()),
+				CustomerVrrpIP: types.StringValue(peering.GetCustomerVrrpIp()),
+			})
+		}
+		if peering, ok := connection.GetPeeringIpv6Ok(); ok {
+			connections[i].PeeringIpv6 = fwtypes.NewObjectValueOf[peeringModel](ctx, &peeringModel{
+				Prefix:         types.StringValue(peering.GetPrefix()),
+				PrefixLength:   types.Int32Value(peering.GetPrefixLength()),
+				EquinixPeerIP:  types.StringValue(peering.GetEquinixPeerIp()),
+				CustomerPeerIP: types.StringValue(peering.GetCustomerPeerIp()),
+				EquinixVrrpIP:  types.StringValue(peering.GetEquinixVrrpIp()),
+				CustomerVrrpIP: types.StringValue(peering.GetCustomerVrrpIp()),
+			})
+		}
+	}
+
+	bgpDetails := routingProtocol.AdditionalProperties
+	model := &routingProtocolModel{
+		Type:             types.StringValue(string(routingProtocol.GetType())),
+		IPBlockUUIDs:     ipBlocks,
+		Connections:      fwtypes.NewListNestedObjectValueOfValueSlice[connectionModel](ctx, connections),
+		ExportPolicy:     types.StringNull(),
+		CustomerAsn:      types.Int64Null(),
+		CustomerAsnRange: types.StringNull(),
+		BgpAuthKey:       prior.BgpAuthKey,
+	}
+	if exportPolicy, ok := bgpDetails["exportPolicy"].(string); ok {
+		model.ExportPolicy = types.StringValue(exportPolicy)
+	}
+	// JSON numbers are decoded as float64 in additional properties
+	if customerAsn, ok := bgpDetails["customerAsn"].(float64); ok {
+		model.CustomerAsn = types.Int64Value(int64(customerAsn))
+	}
+	if customerAsnRange, ok := bgpDetails["customerAsnRange"].(string); ok {
+		model.CustomerAsnRange = types.StringValue(customerAsnRange)
+	}
+	if bgpAuthKey, ok := bgpDetails["bgpAuthKey"].(string); ok {
+		model.BgpAuthKey = types.StringValue(bgpAuthKey)
+	}
+	if model.BgpAuthKey.IsUnknown() {
+		model.BgpAuthKey = types.StringNull()
+	}
+
+	return model, diags
+}
+
+// connectionsRequireReplace requires replacing the service when its
+// connections or their configured Equinix peering IPs change, ignoring the
+// peering details computed by the API
+func connectionsRequireReplace(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.PlanValue.IsUnknown() {
+		resp.RequiresReplace = true
+		return
+	}
+	var plan, state []connectionModel
+	resp.Diagnostics.Append(req.PlanValue.ElementsAs(ctx, &plan, false)...)
+	resp.Diagnostics.Append(req.StateValue.ElementsAs(ctx, &state, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(plan) != len(state) {
+		resp.RequiresReplace = true
+		return
+	}
+	for i := range plan {
+		if !plan[i].UUID.Equal(state[i].UUID) ||
+			peeringIPsChanged(ctx, plan[i].PeeringIpv4, state[i].PeeringIpv4) ||
+			peeringIPsChanged(ctx, plan[i].PeeringIpv6, state[i].PeeringIpv6) {
+			resp.RequiresReplace = true
+			return
+		}
+	}
+}
+
+func peeringIPsChanged(ctx context.Context, plan, state fwtypes.ObjectValueOf[peeringModel]) bool {
+	if plan.IsNull() || plan.IsUnknown() {
+		return false
+	}
+	planPeering, diags := plan.ToPtr(ctx)
+	if diags.HasError() {
+		return true
+	}
+	statePeering := &peeringModel{}
+	if !state.IsNull() && !state.IsUnknown() {
+		statePeering, diags = state.ToPtr(ctx)
+		if diags.HasError() {
+			return true
+		}
+	}
+	changed := func(planIP, stateIP types.String) bool {
+		return !planIP.IsNull() && !planIP.IsUnknown() && !planIP.Equal(stateIP)
+	}
+	return changed(planPeering.EquinixPeerIP, statePeering.EquinixPeerIP) ||
+		changed(planPeering.EquinixVrrpIP, statePeering.EquinixVrrpIP)
+}
+
+func sameElements(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, element := range a {
+		if !slices.Contains(b, element) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,525 @@
+// Package internetaccess for Fabric Internet Access service resource and data sources
+package internetaccess
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// NewResource creates new Internet Access service resource
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: framework.NewBaseResource(
+			framework.BaseResourceConfig{
+				Name: "equinix_fabric_internet_access_service",
+			},
+		),
+	}
+}
+
+// Resource represents Internet Access service resource
+type Resource struct {
+	framework.BaseResource
+}
+
+// Schema returns the resource schema
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = resourceSchema(ctx)
+}
+
+// Create orders a new Internet Access service and waits for it to be provisioned
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan resourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	createRequest, diags := buildCreateRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, _, err := client.InternetAccessServicesApi.CreateEiaService(ctx).InternetAccessPostRequest(createRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Failed creating Internet Access service", equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 30*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	createWaiter := getCreateUpdateWaiter(ctx, client, service.GetUuid(), createTimeout)
+	serviceChecked, err := createWaiter.WaitForStateContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed creating Internet Access service %s", service.GetUuid()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.parse(ctx, serviceChecked.(*fabricv4.InternetAccessService))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
+}
+
+// Read retrieves the Internet Access service
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state resourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	id := state.ID.ValueString()
+
+	service, httpResp, err := client.InternetAccessServicesApi.GetEiaService(ctx, id).Execute()
+	if err == nil {
+		err = equinix_errors.CheckFabricDeletedState(id, string(service.GetState()))
+	}
+	if err != nil {
+		if equinix_errors.IsFabricNotFound(httpResp, err) {
+			resp.Diagnostics.Append(equinix_errors.NewResourceNotFoundWarningDiagnostic(err))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed retrieving Internet Access service %s", id), equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.parse(ctx, service)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
+}
+
+// Update changes the bandwidth, IP blocks or purchase order number of the
+// Internet Access service and waits for the change to be provisioned
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	var state, plan resourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+
+	updateRequest, diags := buildUpdateRequest(ctx, state, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(updateRequest) > 0 {
+		_, _, err := client.InternetAccessServicesApi.PatchEiaService(ctx, id).InternetAccessPatchOperationUpdate(updateRequest).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed updating Internet Access service %s", id), equinix_errors.FormatFabricError(err).Error())
+			return
+		}
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, 30*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	updateWaiter := getCreateUpdateWaiter(ctx, client, id, updateTimeout)
+	serviceChecked, err := updateWaiter.WaitForStateContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed updating Internet Access service %s", id), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.parse(ctx, serviceChecked.(*fabricv4.InternetAccessService))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
+}
+
+// Delete removes the Internet Access service and waits for it to be deprovisioned
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	var state resourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+
+	_, deleteResp, err := client.InternetAccessServicesApi.DeleteEiaService(ctx, id).Execute()
+	if err != nil {
+		if deleteResp == nil || !slices.Contains([]int{http.StatusForbidden, http.StatusNotFound}, deleteResp.StatusCode) {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed deleting Internet Access service %s", id), equinix_errors.FormatFabricError(err).Error())
+			return
+		}
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, 30*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	deleteWaiter := getDeleteWaiter(ctx, client, id, deleteTimeout)
+	_, err = deleteWaiter.WaitForStateContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed deleting Internet Access service %s", id), err.Error())
+		return
+	}
+}
+
+func buildCreateRequest(ctx context.Context, plan resourceModel) (fabricv4.InternetAccessPostRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	request := fabricv4.InternetAccessPostRequest{
+		Type: fabricv4.InternetAccessServiceType(plan.Type.ValueString()),
+		Name: plan.Name.ValueString(),
+	}
+	if isKnown(plan.Bandwidth) {
+		request.SetBandwidth(plan.Bandwidth.ValueInt32())
+	}
+	if isKnown(plan.BandwidthCommit) {
+		request.SetBandwidthCommit(plan.BandwidthCommit.ValueInt32())
+	}
+
+	billing, diags := plan.Billing.ToPtr(ctx)
+	if diags.HasError() {
+		return fabricv4.InternetAccessPostRequest{}, diags
+	}
+	request.SetBilling(fabricv4.InternetAccessPostRequestBilling{
+		Type: fabricv4.InternetAccessBillingType(billing.Type.ValueString()),
+	})
+
+	project, diags := plan.Project.ToPtr(ctx)
+	if diags.HasError() {
+		return fabricv4.InternetAccessPostRequest{}, diags
+	}
+	request.SetProject(fabricv4.Project{ProjectId: project.ProjectID.ValueString()})
+
+	account, diags := plan.Account.ToPtr(ctx)
+	if diags.HasError() {
+		return fabricv4.InternetAccessPostRequest{}, diags
+	}
+	request.SetAccount(fabricv4.InternetAccessAccount{AccountNumber: account.AccountNumber.ValueString()})
+
+	if isKnown(plan.Order) {
+		order, diags := plan.Order.ToPtr(ctx)
+		if diags.HasError() {
+			return fabricv4.InternetAccessPostRequest{}, diags
+		}
+		if isKnown(order.PurchaseOrderNumber) {
+			orderRequest := fabricv4.InternetAccessOrderRequest{}
+			orderRequest.SetPurchaseOrderNumber(order.PurchaseOrderNumber.ValueString())
+			request.SetOrder(orderRequest)
+		}
+	}
+
+	routingProtocol, diags := plan.RoutingProtocol.ToPtr(ctx)
+	if diags.HasError() {
+		return fabricv4.InternetAccessPostRequest{}, diags
+	}
+	routingProtocolRequest, diags := buildRoutingProtocolRequest(ctx, routingProtocol)
+	if diags.HasError() {
+		return fabricv4.InternetAccessPostRequest{}, diags
+	}
+	request.SetRoutingProtocol(routingProtocolRequest)
+
+	return request, diags
+}
+
+// buildRoutingProtocolRequest builds the routing protocol of the service. The
+// request type only models the fields shared by all routing protocols, the
+// connections and BGP details are sent as its additional properties
+func buildRoutingProtocolRequest(ctx context.Context, routingProtocol *routingProtocolModel) (fabricv4.InternetAccessRoutingProtocolRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	customerRoutes, diags := buildCustomerRoutesRequest(ctx, routingProtocol)
+	if diags.HasError() {
+		return fabricv4.InternetAccessRoutingProtocolRequest{}, diags
+	}
+	request := fabricv4.InternetAccessRoutingProtocolRequest{
+		Type:                 fabricv4.InternetAccessRoutingProtocolType(routingProtocol.Type.ValueString()),
+		CustomerRoutes:       customerRoutes,
+		AdditionalProperties: map[string]interface{}{},
+	}
+
+	connections, diags := routingProtocol.Connections.ToSlice(ctx)
+	if diags.HasError() {
+		return fabricv4.InternetAccessRoutingProtocolRequest{}, diags
+	}
+
+	switch request.Type {
+	case fabricv4.INTERNETACCESSROUTINGPROTOCOLTYPE_BGP:
+		connectionsRequest := make([]fabricv4.InternetAccessConnectionBgpRequest, len(connections))
+		for i, connection := range connections {
+			connectionsRequest[i] = fabricv4.InternetAccessConnectionBgpRequest{Uuid: connection.UUID.ValueString()}
+		}
+		request.AdditionalProperties["connections"] = connectionsRequest
+		if isKnown(routingProtocol.ExportPolicy) {
+			request.AdditionalProperties["exportPolicy"] = routingProtocol.ExportPolicy.ValueString()
+		}
+		if isKnown(routingProtocol.CustomerAsn) {
+			request.AdditionalProperties["customerAsn"] = routingProtocol.CustomerAsn.ValueInt64()
+		}
+		if isKnown(routingProtocol.CustomerAsnRange) {
+			request.AdditionalProperties["customerAsnRange"] = routingProtocol.CustomerAsnRange.ValueString()
+		}
+		if isKnown(routingProtocol.BgpAuthKey) {
+			request.AdditionalProperties["bgpAuthKey"] = routingProtocol.BgpAuthKey.ValueString()
+		}
+	case fabricv4.INTERNETACCESSROUTINGPROTOCOLTYPE_STATIC:
+		connectionsRequest := make([]fabricv4.InternetAccessConnectionStaticRequest, len(connections))
+		for i, connection := range connections {
+			connectionsRequest[i] = fabricv4.InternetAccessConnectionStaticRequest{Uuid: connection.UUID.ValueString()}
+		}
+		request.AdditionalProperties["connections"] = connectionsRequest
+	case fabricv4.INTERNETACCESSROUTINGPROTOCOLTYPE_DIRECT:
+		connectionsRequest := make([]fabricv4.InternetAccessConnectionDirectRequest, len(connections))
+		for i, connection := range connections {
+			connectionsRequest[i] = fabricv4.InternetAccessConnectionDirectRequest{Uuid: connection.UUID.ValueString()}
+			if isKnown(connection.PeeringIpv4) {
+				peering, diags := connection.PeeringIpv4.ToPtr(ctx)
+				if diags.HasError() {
+					return fabricv4.InternetAccessRoutingProtocolRequest{}, diags
+				}
+				connectionsRequest[i].SetPeeringIpv4(fabricv4.InternetAccessPeeringIpv4Request{
+					EquinixPeerIp: knownStringPointer(peering.EquinixPeerIP),
+					EquinixVrrpIp: knownStringPointer(peering.EquinixVrrpIP),
+				})
+			}
+			if isKnown(connection.PeeringIpv6) {
+				peering, diags := connection.PeeringIpv6.ToPtr(ctx)
+				if diags.HasError() {
+					return fabricv4.InternetAccessRoutingProtocolRequest{}, diags
+				}
+				connectionsRequest[i].SetPeeringIpv6(fabricv4.InternetAccessPeeringIpv6Request{
+					EquinixPeerIp: knownStringPointer(peering.EquinixPeerIP),
+					EquinixVrrpIp: knownStringPointer(peering.EquinixVrrpIP),
+				})
+			}
+		}
+		request.AdditionalProperties["connections"] = connectionsRequest
+	}
+
+	return request, diags
+}
+
+func buildCustomerRoutesRequest(ctx context.Context, routingProtocol *routingProtocolModel) ([]fabricv4.InternetAccessCustomerRouteRequest, diag.Diagnostics) {
+	var ipBlockUUIDs []string
+	diags := routingProtocol.IPBlockUUIDs.ElementsAs(ctx, &ipBlockUUIDs, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	customerRoutes := make([]fabricv4.InternetAccessCustomerRouteRequest, len(ipBlockUUIDs))
+	for i, uuid := range ipBlockUUIDs {
+		customerRoutes[i] = fabricv4.InternetAccessCustomerRouteRequest{
+			IpBlock: fabricv4.InternetAccessIpBlockRequest{Uuid: uuid},
+		}
+	}
+	return customerRoutes, diags
+}
+
+// buildUpdateRequest returns the patch operations for the updatable attributes
+// of the service; its bandwidth, IP blocks and purchase order number
+func buildUpdateRequest(ctx context.Context, state, plan resourceModel) ([]fabricv4.InternetAccessPatchOperationUpdate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	updateRequest := make([]fabricv4.InternetAccessPatchOperationUpdate, 0)
+
+	if isKnown(plan.Bandwidth) && !plan.Bandwidth.Equal(state.Bandwidth) {
+		updateRequest = append(updateRequest, fabricv4.InternetAccessPatchOperationUpdate{
+			Op:    fabricv4.INTERNETACCESSPATCHOPERATIONUPDATEALLOWEDOP_REPLACE,
+			Path:  "/bandwidth",
+			Value: plan.Bandwidth.ValueInt32(),
+		})
+	}
+	if isKnown(plan.BandwidthCommit) && !plan.BandwidthCommit.Equal(state.BandwidthCommit) {
+		updateRequest = append(updateRequest, fabricv4.InternetAccessPatchOperationUpdate{
+			Op:    fabricv4.INTERNETACCESSPATCHOPERATIONUPDATEALLOWEDOP_REPLACE,
+			Path:  "/bandwidthCommit",
+			Value: plan.BandwidthCommit.ValueInt32(),
+		})
+	}
+
+	planRoutingProtocol, diags := plan.RoutingProtocol.ToPtr(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+	stateRoutingProtocol, diags := state.RoutingProtocol.ToPtr(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+	var planIPBlocks, stateIPBlocks []string
+	diags.Append(planRoutingProtocol.IPBlockUUIDs.ElementsAs(ctx, &planIPBlocks, false)...)
+	diags.Append(stateRoutingProtocol.IPBlockUUIDs.ElementsAs(ctx, &stateIPBlocks, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if !sameElements(planIPBlocks, stateIPBlocks) {
+		customerRoutes, diags := buildCustomerRoutesRequest(ctx, planRoutingProtocol)
+		if diags.HasError() {
+			return nil, diags
+		}
+		updateRequest = append(updateRequest, fabricv4.InternetAccessPatchOperationUpdate{
+			Op:    fabricv4.INTERNETACCESSPATCHOPERATIONUPDATEALLOWEDOP_REPLACE,
+			Path:  "/routingProtocol/customerRoutes",
+			Value: customerRoutes,
+		})
+	}
+
+	if isKnown(plan.Order) {
+		planOrder, diags := plan.Order.ToPtr(ctx)
+		if diags.HasError() {
+			return nil, diags
+		}
+		statePurchaseOrderNumber := types.StringNull()
+		if isKnown(state.Order) {
+			stateOrder, diags := state.Order.ToPtr(ctx)
+			if diags.HasError() {
+				return nil, diags
+			}
+			statePurchaseOrderNumber = stateOrder.PurchaseOrderNumber
+		}
+		if !planOrder.PurchaseOrderNumber.Equal(statePurchaseOrderNumber) {
+			op := fabricv4.INTERNETACCESSPATCHOPERATIONUPDATEALLOWEDOP_REPLACE
+			if statePurchaseOrderNumber.ValueString() == "" {
+				op = fabricv4.INTERNETACCESSPATCHOPERATIONUPDATEALLOWEDOP_ADD
+			} else if planOrder.PurchaseOrderNumber.ValueString() == "" {
+				op = fabricv4.INTERNETACCESSPATCHOPERATIONUPDATEALLOWEDOP_REMOVE
+			}
+			update := fabricv4.InternetAccessPatchOperationUpdate{
+				Op:   op,
+				Path: "/order/purchaseOrderNumber",
+			}
+			if op != fabricv4.INTERNETACCESSPATCHOPERATIONUPDATEALLOWEDOP_REMOVE {
+				update.Value = planOrder.PurchaseOrderNumber.ValueString()
+			}
+			updateRequest = append(updateRequest, update)
+		}
+	}
+
+	return updateRequest, diags
+}
+
+func getCreateUpdateWaiter(ctx context.Context, client *fabricv4.APIClient, id string, timeout time.Duration) *retry.StateChangeConf {
+	return &retry.StateChangeConf{
+		Pending: []string{
+			string(fabricv4.INTERNETACCESSSERVICESTATE_PROVISIONING),
+		},
+		Target: []string{
+			string(fabricv4.INTERNETACCESSSERVICESTATE_PROVISIONED),
+		},
+		Refresh: func() (any, string, error) {
+			service, _, err := client.InternetAccessServicesApi.GetEiaService(ctx, id).Execute()
+			if err != nil {
+				return 0, "", equinix_errors.FormatFabricError(err)
+			}
+			return service, string(service.GetState()), nil
+		},
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+}
+
+func getDeleteWaiter(ctx context.Context, client *fabricv4.APIClient, id string, timeout time.Duration) *retry.StateChangeConf {
+	// deletedMarker is a terraform-provider-only value that is used by the waiter
+	// to indicate that the service appears to be deleted successfully based on
+	// status code
+	deletedMarker := "tf-marker-for-deleted-internet-access-service"
+	return &retry.StateChangeConf{
+		Pending: []string{
+			string(fabricv4.INTERNETACCESSSERVICESTATE_PROVISIONED),
+			string(fabricv4.INTERNETACCESSSERVICESTATE_DEPROVISIONING),
+		},
+		Target: []string{
+			deletedMarker,
+			string(fabricv4.INTERNETACCESSSERVICESTATE_DEPROVISIONED),
+		},
+		Refresh: func() (any, string, error) {
+			service, resp, err := client.InternetAccessServicesApi.GetEiaService(ctx, id).Execute()
+			if err != nil {
+				if resp != nil && slices.Contains([]int{http.StatusForbidden, http.StatusNotFound}, resp.StatusCode) {
+					return service, deletedMarker, nil
+				}
+				return 0, "", equinix_errors.FormatFabricError(err)
+			}
+			return service, string(service.GetState()), nil
+		},
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+}
+
+type knownValue interface {
+	IsNull() bool
+	IsUnknown() bool
+}
+
+func isKnown(value knownValue) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
+func knownStringPointer(value types.String) *string {
+	if !isKnown(value) {
+		return nil
+	}
+	return value.ValueStringPointer()
+}
@@ -0,0 +1,103 @@
+package internetaccess
+
+import (
+	"context"
+	"testing"
+
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testRoutingProtocol(ctx context.Context, routingProtocolType string, ipBlockUUIDs ...string) fwtypes.ObjectValueOf[routingProtocolModel] {
+	ipBlocks := make([]attr.Value, len(ipBlockUUIDs))
+	for i, uuid := range ipBlockUUIDs {
+		ipBlocks[i] = types.StringValue(uuid)
+	}
+	return fwtypes.NewObjectValueOf[routingProtocolModel](ctx, &routingProtocolModel{
+		Type:         types.StringValue(routingProtocolType),
+		IPBlockUUIDs: fwtypes.NewListValueOfMust[types.String](ctx, ipBlocks),
+		Connections: fwtypes.NewListNestedObjectValueOfValueSlice[connectionModel](ctx, []connectionModel{{
+			UUID:        types.StringValue("connection-uuid"),
+			Href:        types.StringUnknown(),
+			PeeringIpv4: fwtypes.NewObjectValueOfNull[peeringModel](ctx),
+			PeeringIpv6: fwtypes.NewObjectValueOfNull[peeringModel](ctx),
+		}}),
+		ExportPolicy:     types.StringValue("FULL"),
+		CustomerAsn:      types.Int64Value(65001),
+		CustomerAsnRange: types.StringUnknown(),
+		BgpAuthKey:       types.StringNull(),
+	})
+}
+
+func TestInternetAccess_buildRoutingProtocolRequest(t *testing.T) {
+	// given
+	ctx := context.Background()
+	bgp, _ := testRoutingProtocol(ctx, "BGP", "ip-block-uuid").ToPtr(ctx)
+	static, _ := testRoutingProtocol(ctx, "STATIC", "ip-block-uuid").ToPtr(ctx)
+	// when
+	bgpRequest, bgpDiags := buildRoutingProtocolRequest(ctx, bgp)
+	staticRequest, staticDiags := buildRoutingProtocolRequest(ctx, static)
+	// then
+	assert.False(t, bgpDiags.HasError())
+	assert.False(t, staticDiags.HasError())
+	assert.Equal(t, []fabricv4.InternetAccessCustomerRouteRequest{{IpBlock: fabricv4.InternetAccessIpBlockRequest{Uuid: "ip-block-uuid"}}}, bgpRequest.CustomerRoutes)
+	assert.Equal(t, []fabricv4.InternetAccessConnectionBgpRequest{{Uuid: "connection-uuid"}}, bgpRequest.AdditionalProperties["connections"])
+	assert.Equal(t, "FULL", bgpRequest.AdditionalProperties["exportPolicy"])
+	assert.Equal(t, int64(65001), bgpRequest.AdditionalProperties["customerAsn"])
+	assert.NotContains(t, bgpRequest.AdditionalProperties, "customerAsnRange", "Unknown BGP details are left to the API")
+	assert.Equal(t, []fabricv4.InternetAccessConnectionStaticRequest{{Uuid: "connection-uuid"}}, staticRequest.AdditionalProperties["connections"])
+	assert.NotContains(t, staticRequest.AdditionalProperties, "exportPolicy", "BGP details are only sent for BGP")
+}
+
+func TestInternetAccess_buildUpdateRequest(t *testing.T) {
+	// given
+	ctx := context.Background()
+	state := resourceModel{baseModel: baseModel{
+		Bandwidth:       types.Int32Value(100),
+		BandwidthCommit: types.Int32Null(),
+		RoutingProtocol: testRoutingProtocol(ctx, "BGP", "ip-block-1", "ip-block-2"),
+		Order: fwtypes.NewObjectValueOf[orderModel](ctx, &orderModel{
+			PurchaseOrderNumber: types.StringNull(),
+		}),
+	}}
+	reordered := state
+	reordered.RoutingProtocol = testRoutingProtocol(ctx, "BGP", "ip-block-2", "ip-block-1")
+	plan := state
+	plan.Bandwidth = types.Int32Value(200)
+	plan.RoutingProtocol = testRoutingProtocol(ctx, "BGP", "ip-block-1", "ip-block-3")
+	plan.Order = fwtypes.NewObjectValueOf[orderModel](ctx, &orderModel{
+		PurchaseOrderNumber: types.StringValue("PO-1"),
+	})
+	// when
+	noUpdates, noUpdatesDiags := buildUpdateRequest(ctx, state, reordered)
+	updates, updatesDiags := buildUpdateRequest(ctx, state, plan)
+	// then
+	assert.False(t, noUpdatesDiags.HasError())
+	assert.False(t, updatesDiags.HasError())
+	assert.Empty(t, noUpdates, "Reordering the IP blocks does not update the service")
+	assert.Equal(t, []fabricv4.InternetAccessPatchOperationUpdate{
+		{
+			Op:    fabricv4.INTERNETACCESSPATCHOPERATIONUPDATEALLOWEDOP_REPLACE,
+			Path:  "/bandwidth",
+			Value: int32(200),
+		},
+		{
+			Op:   fabricv4.INTERNETACCESSPATCHOPERATIONUPDATEALLOWEDOP_REPLACE,
+			Path: "/routingProtocol/customerRoutes",
+			Value: []fabricv4.InternetAccessCustomerRouteRequest{
+				{IpBlock: fabricv4.InternetAccessIpBlockRequest{Uuid: "ip-block-1"}},
+				{IpBlock: fabricv4.InternetAccessIpBlockRequest{Uuid: "ip-block-3"}},
+			},
+		},
+		{
+			Op:    fabricv4.INTERNETACCESSPATCHOPERATIONUPDATEALLOWEDOP_ADD,
+			Path:  "/order/purchaseOrderNumber",
+			Value: "PO-1",
+		},
+	}, updates)
+}
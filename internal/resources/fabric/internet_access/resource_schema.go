@@ -0,0 +1,415 @@
+// Package internetaccess for Fabric Internet Access service resource and data sources
+package internetaccess
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/equinix/terraform-provider-equinix/internal/converters"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func resourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: `Fabric V4 API compatible resource allows creation and management of Equinix Fabric Internet Access services
+
+Additional Documentation:
+* Getting Started: https://docs.equinix.com/internet-access/
+* API: https://docs.equinix.com/api-catalog/fabricv4/#tag/Internet-Access-Services`,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttributeDefaultDescription(),
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+			"type": schema.StringAttribute{
+				Description: fmt.Sprintf("Internet Access service type. One of %v", fabricv4.AllowedInternetAccessServiceTypeEnumValues),
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(converters.EnumArrayToStringArray(fabricv4.AllowedInternetAccessServiceTypeEnumValues)...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Customer-provided name of the Internet Access service",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bandwidth": schema.Int32Attribute{
+				Description: "Bandwidth of the Internet Access service in Mbps",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"bandwidth_commit": schema.Int32Attribute{
+				Description: "Minimum bandwidth commit in Mbps, for services billed with the BURST_BASED billing type",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"billing": schema.SingleNestedAttribute{
+				Description: "Billing details of the Internet Access service",
+				Required:    true,
+				CustomType:  fwtypes.NewObjectTypeOf[billingModel](ctx),
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: fmt.Sprintf("Billing type of the Internet Access service. One of %v", fabricv4.AllowedInternetAccessBillingTypeEnumValues),
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(converters.EnumArrayToStringArray(fabricv4.AllowedInternetAccessBillingTypeEnumValues)...),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"enabled": schema.BoolAttribute{
+						Description: "Boolean value indicating whether the billing of the service is enabled",
+						Computed:    true,
+					},
+					"start_date": schema.StringAttribute{
+						Description: "Start date of the billing period of the service",
+						Computed:    true,
+					},
+				},
+			},
+			"project": schema.SingleNestedAttribute{
+				Description: "Equinix Project attribute object",
+				Required:    true,
+				CustomType:  fwtypes.NewObjectTypeOf[projectModel](ctx),
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"project_id": schema.StringAttribute{
+						Description: "Equinix Subscriber-assigned project ID",
+						Required:    true,
+					},
+				},
+			},
+			"account": schema.SingleNestedAttribute{
+				Description: "Equinix account the Internet Access service is billed to",
+				Required:    true,
+				CustomType:  fwtypes.NewObjectTypeOf[accountModel](ctx),
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"account_number": schema.StringAttribute{
+						Description: "Equinix account number",
+						Required:    true,
+					},
+				},
+			},
+			"order": schema.SingleNestedAttribute{
+				Description: "Order details of the Internet Access service",
+				Optional:    true,
+				Computed:    true,
+				CustomType:  fwtypes.NewObjectTypeOf[orderModel](ctx),
+				Attributes: map[string]schema.Attribute{
+					"purchase_order_number": schema.StringAttribute{
+						Description: "Purchase order number",
+						Optional:    true,
+					},
+					"customer_reference_number": schema.StringAttribute{
+						Description: "Customer reference number",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"billing_tier": schema.StringAttribute{
+						Description: "Billing tier of the service bandwidth",
+						Computed:    true,
+					},
+					"order_id": schema.StringAttribute{
+						Description: "Order identifier",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"order_number": schema.StringAttribute{
+						Description: "Order reference number",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"term_length": schema.Int32Attribute{
+						Description: "Term length in months",
+						Computed:    true,
+						PlanModifiers: []planmodifier.Int32{
+							int32planmodifier.UseStateForUnknown(),
+						},
+					},
+					"contracted_bandwidth": schema.Int32Attribute{
+						Description: "Contracted bandwidth in Mbps",
+						Computed:    true,
+					},
+				},
+			},
+			"routing_protocol": schema.SingleNestedAttribute{
+				Description: "Routing protocol of the Internet Access service and the Fabric connections it is delivered over",
+				Required:    true,
+				CustomType:  fwtypes.NewObjectTypeOf[routingProtocolModel](ctx),
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: fmt.Sprintf("Routing protocol type. One of %v", fabricv4.AllowedInternetAccessRoutingProtocolTypeEnumValues),
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(converters.EnumArrayToStringArray(fabricv4.AllowedInternetAccessRoutingProtocolTypeEnumValues)...),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"ip_block_uuids": schema.ListAttribute{
+						Description: "UUIDs of the Fabric IP blocks advertised as customer routes of the service",
+						Required:    true,
+						CustomType:  fwtypes.ListOfStringType,
+						ElementType: types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.UniqueValues(),
+						},
+					},
+					"connections": schema.ListNestedAttribute{
+						Description: "Fabric connections the Internet Access service is delivered over",
+						Required:    true,
+						CustomType:  fwtypes.NewListNestedObjectTypeOf[connectionModel](ctx),
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+						PlanModifiers: []planmodifier.List{
+							listplanmodifier.RequiresReplaceIf(connectionsRequireReplace,
+								"Changing the connections or their Equinix peering IPs requires replacing the service",
+								"Changing the connections or their Equinix peering IPs requires replacing the service"),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"uuid": schema.StringAttribute{
+									Description: "Equinix-assigned connection identifier",
+									Required:    true,
+								},
+								"href": schema.StringAttribute{
+									Description: "Connection URI",
+									Computed:    true,
+									PlanModifiers: []planmodifier.String{
+										stringplanmodifier.UseStateForUnknown(),
+									},
+								},
+								"peering_ipv4": peeringSchema(ctx, "IPv4"),
+								"peering_ipv6": peeringSchema(ctx, "IPv6"),
+							},
+						},
+					},
+					"export_policy": schema.StringAttribute{
+						Description: fmt.Sprintf("Routes exported to the customer over BGP. One of %v", fabricv4.AllowedInternetAccessExportPolicyEnumValues),
+						Optional:    true,
+						Computed:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(converters.EnumArrayToStringArray(fabricv4.AllowedInternetAccessExportPolicyEnumValues)...),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"customer_asn": schema.Int64Attribute{
+						Description: "Customer ASN for BGP peering. Valid range is 1-64495 or 131072-4199999999",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+							int64planmodifier.RequiresReplace(),
+						},
+					},
+					"customer_asn_range": schema.StringAttribute{
+						Description: fmt.Sprintf("Range of the customer ASN for BGP peering. One of %v", fabricv4.AllowedInternetAccessCustomerAsnRangeEnumValues),
+						Optional:    true,
+						Computed:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(converters.EnumArrayToStringArray(fabricv4.AllowedInternetAccessCustomerAsnRangeEnumValues)...),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"bgp_auth_key": schema.StringAttribute{
+						Description: "BGP authentication key",
+						Optional:    true,
+						Sensitive:   true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+			"href": schema.StringAttribute{
+				Description: "Internet Access service URI",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				Description: "Equinix-assigned unique identifier of the Internet Access service",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "Value representing provisioning status of the Internet Access service",
+				Computed:    true,
+			},
+			"use_case": schema.StringAttribute{
+				Description: "Use case of the Internet Access service",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"locations": schema.ListNestedAttribute{
+				Description: "Locations the Internet Access service is delivered in",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[locationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"metro_code": schema.StringAttribute{
+							Description: "Metro code",
+							Computed:    true,
+						},
+						"region": schema.StringAttribute{
+							Description: "Region of the metro",
+							Computed:    true,
+						},
+						"ibx": schema.StringAttribute{
+							Description: "IBX data center code",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"change_log": schema.SingleNestedAttribute{
+				Description: "Details of the last change on the Internet Access service",
+				Computed:    true,
+				CustomType:  fwtypes.NewObjectTypeOf[changeLogModel](ctx),
+				Attributes: map[string]schema.Attribute{
+					"created_by": schema.StringAttribute{
+						Description: "User name of creator of the Internet Access service",
+						Computed:    true,
+					},
+					"created_date_time": schema.StringAttribute{
+						Description: "Creation time of the Internet Access service",
+						Computed:    true,
+					},
+					"updated_by": schema.StringAttribute{
+						Description: "User name of last updater of the Internet Access service",
+						Computed:    true,
+					},
+					"updated_date_time": schema.StringAttribute{
+						Description: "Last update time of the Internet Access service",
+						Computed:    true,
+					},
+					"deleted_by": schema.StringAttribute{
+						Description: "User name of deleter of the Internet Access service",
+						Computed:    true,
+					},
+					"deleted_date_time": schema.StringAttribute{
+						Description: "Deletion time of the Internet Access service",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func peeringSchema(ctx context.Context, version string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: fmt.Sprintf("%s peering details of the connection. Equinix peering and VRRP IPs can only be set for the DIRECT routing protocol", version),
+		Optional:    true,
+		Computed:    true,
+		CustomType:  fwtypes.NewObjectTypeOf[peeringModel](ctx),
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"prefix": schema.StringAttribute{
+				Description: fmt.Sprintf("%s prefix of the peering", version),
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prefix_length": schema.Int32Attribute{
+				Description: "Size of the peering subnet",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"equinix_peer_ip": schema.StringAttribute{
+				Description: fmt.Sprintf("%s peering IP address of the Equinix side", version),
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_peer_ip": schema.StringAttribute{
+				Description: fmt.Sprintf("%s peering IP address of the customer side", version),
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"equinix_vrrp_ip": schema.StringAttribute{
+				Description: fmt.Sprintf("%s VRRP IP address of the Equinix side", version),
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_vrrp_ip": schema.StringAttribute{
+				Description: fmt.Sprintf("%s VRRP IP address of the customer side", version),
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
@@ -2,9 +2,10 @@ package marketplace
 
 import (
 	"context"
+	"fmt"
 	"log"
-	"strings"
 
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
 	"github.com/equinix/terraform-provider-equinix/internal/config"
 	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -27,10 +28,10 @@ func dataSourceFabricMarketplaceSubscriptionRead(ctx context.Context, d *schema.
 
 func fabricMarketplaceSubscriptionRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
-	subscription, _, err := client.MarketplaceSubscriptionsApi.GetSubscriptionById(ctx, d.Id()).Execute()
+	subscription, httpResp, err := client.MarketplaceSubscriptionsApi.GetSubscriptionById(ctx, d.Id()).Execute()
 	if err != nil {
 		log.Printf("[WARN] Subscription %s not found , error %s", d.Id(), err)
-		if !strings.Contains(err.Error(), "500") {
+		if equinix_errors.IsFabricNotFound(httpResp, err) {
 			d.SetId("")
 		}
 		return diag.FromErr(equinix_errors.FormatFabricError(err))
@@ -38,3 +39,65 @@ func fabricMarketplaceSubscriptionRead(ctx context.Context, d *schema.ResourceDa
 	d.SetId(subscription.GetUuid())
 	return setFabricMap(d, subscription)
 }
+
+func DataSourceFabricMarketplaceSubscriptions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFabricMarketplaceSubscriptionsRead,
+		Schema:      fabricMarketplaceSubscriptionsSearchSchema(),
+		Description: `Fabric V4 API compatible data resource that allow user to fetch the Marketplace Subscriptions matching the given search criteria
+
+Fabric does not expose an API to list Marketplace Subscriptions, so the subscriptions to search through are passed in with the uuids argument and filtered on marketplace, status, offer type and entitlement asset type`,
+	}
+}
+
+func dataSourceFabricMarketplaceSubscriptionsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
+	uuids := d.Get("uuids").([]any)
+	criteria := subscriptionSearchCriteria{
+		marketplace: d.Get("marketplace").(string),
+		status:      d.Get("status").(string),
+		offerType:   d.Get("offer_type").(string),
+		assetType:   d.Get("asset_type").(string),
+	}
+
+	var subscriptions []fabricv4.SubscriptionResponse
+	for _, uuid := range uuids {
+		subscription, _, err := client.MarketplaceSubscriptionsApi.GetSubscriptionById(ctx, uuid.(string)).Execute()
+		if err != nil {
+			return diag.FromErr(equinix_errors.FormatFabricError(err))
+		}
+		if criteria.matches(subscription) {
+			subscriptions = append(subscriptions, *subscription)
+		}
+	}
+
+	if len(subscriptions) < 1 {
+		return diag.FromErr(fmt.Errorf("no records are found for the marketplace subscription search criteria provided - %d , please change the search criteria", len(subscriptions)))
+	}
+
+	d.SetId(subscriptions[0].GetUuid())
+	return setFabricSubscriptionsData(d, subscriptions)
+}
+
+// ValidateSubscriptionDiff ensures that the subscription referenced in the
+// marketplace_subscription block of the planned resource can still be consumed.
+// Validation is skipped while the subscription uuid is unknown
+func ValidateSubscriptionDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("marketplace_subscription") {
+		return nil
+	}
+	subscriptions := d.Get("marketplace_subscription").(*schema.Set).List()
+	if len(subscriptions) == 0 {
+		return nil
+	}
+	uuid, _ := subscriptions[0].(map[string]any)["uuid"].(string)
+	if uuid == "" {
+		return nil
+	}
+	client := meta.(*config.Config).NewFabricClientForSDKPlan(ctx)
+	subscription, _, err := client.MarketplaceSubscriptionsApi.GetSubscriptionById(ctx, uuid).Execute()
+	if err != nil {
+		return fmt.Errorf("cannot validate marketplace subscription %q: %w", uuid, equinix_errors.FormatFabricError(err))
+	}
+	return validateSubscriptionAvailable(subscription)
+}
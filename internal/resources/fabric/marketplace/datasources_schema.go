@@ -1,7 +1,13 @@
 package marketplace
 
 import (
+	"fmt"
+
+	"github.com/equinix/terraform-provider-equinix/internal/converters"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func fabricMarketplaceSubscriptionDataSourceSchema() map[string]*schema.Schema {
@@ -51,6 +57,56 @@ func fabricMarketplaceSubscriptionDataSourceSchema() map[string]*schema.Schema {
 	}
 }
 
+func fabricMarketplaceSubscriptionsSearchSchema() map[string]*schema.Schema {
+	subscriptionSchema := fabricMarketplaceSubscriptionDataSourceSchema()
+	subscriptionSchema["uuid"].Required = false
+	subscriptionSchema["uuid"].Computed = true
+	return map[string]*schema.Schema{
+		"uuids": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Description: "Equinix-assigned identifiers of the Marketplace Subscriptions to search through",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		"marketplace": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(converters.EnumArrayToStringArray(fabricv4.AllowedSubscriptionResponseMarketplaceEnumValues), false),
+			Description:  fmt.Sprintf("Only return subscriptions from this marketplace. One of %v", fabricv4.AllowedSubscriptionResponseMarketplaceEnumValues),
+		},
+		"status": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(converters.EnumArrayToStringArray(fabricv4.AllowedSubscriptionStateEnumValues), false),
+			Description:  fmt.Sprintf("Only return subscriptions in this status. One of %v", fabricv4.AllowedSubscriptionStateEnumValues),
+		},
+		"offer_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(converters.EnumArrayToStringArray(fabricv4.AllowedSubscriptionResponseOfferTypeEnumValues), false),
+			Description:  fmt.Sprintf("Only return subscriptions with this offer type. One of %v", fabricv4.AllowedSubscriptionResponseOfferTypeEnumValues),
+		},
+		"asset_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "Only return subscriptions with an entitlement for this asset type like; XF_ROUTER",
+		},
+		"data": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "List of Marketplace Subscriptions matching the search criteria",
+			Elem: &schema.Resource{
+				Schema: subscriptionSchema,
+			},
+		},
+	}
+}
+
 func marketplaceSubscriptionTrialSch() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -39,3 +39,34 @@ func configGetMarketplaceSubscriptionResource(subscriptionID string) string {
 	}
 `, subscriptionID)
 }
+
+func TestAccFabricDataSourceMarketPlaceSubscriptions_PFCR(t *testing.T) {
+	susbcriptionID := testinghelpers.GetFabricMarketPlaceSubscriptionID(t)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.TestAccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configGetMarketplaceSubscriptionsResource(susbcriptionID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.equinix_fabric_market_place_subscriptions.test", "data.#", "1"),
+					resource.TestCheckResourceAttr("data.equinix_fabric_market_place_subscriptions.test", "data.0.uuid", susbcriptionID),
+					resource.TestCheckResourceAttr("data.equinix_fabric_market_place_subscriptions.test", "data.0.status", "ACTIVE"),
+					resource.TestCheckResourceAttr("data.equinix_fabric_market_place_subscriptions.test", "data.0.marketplace", "AWS"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_market_place_subscriptions.test", "data.0.entitlements.0.quantity_available"),
+				),
+			},
+		},
+	})
+}
+
+func configGetMarketplaceSubscriptionsResource(subscriptionID string) string {
+	return fmt.Sprintf(`
+	data "equinix_fabric_market_place_subscriptions" "test"{
+		uuids       = ["%s"]
+		marketplace = "AWS"
+		status      = "ACTIVE"
+		asset_type  = "XF_ROUTER"
+	}
+`, subscriptionID)
+}
@@ -1,6 +1,8 @@
 package marketplace
 
 import (
+	"fmt"
+
 	"github.com/equinix/equinix-sdk-go/services/fabricv4"
 	equinix_schema "github.com/equinix/terraform-provider-equinix/internal/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,6 +19,66 @@ func setFabricMap(d *schema.ResourceData, subs *fabricv4.SubscriptionResponse) d
 	return diags
 }
 
+func setFabricSubscriptionsData(d *schema.ResourceData, subscriptions []fabricv4.SubscriptionResponse) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	mappedSubscriptions := make([]map[string]any, len(subscriptions))
+	for index, subscription := range subscriptions {
+		mappedSubscriptions[index] = subscriptionMap(&subscription)
+	}
+	err := equinix_schema.SetMap(d, map[string]any{
+		"data": mappedSubscriptions,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+// subscriptionSearchCriteria holds the optional filters of the marketplace
+// subscriptions data source, empty values match any subscription
+type subscriptionSearchCriteria struct {
+	marketplace string
+	status      string
+	offerType   string
+	assetType   string
+}
+
+func (c subscriptionSearchCriteria) matches(subscription *fabricv4.SubscriptionResponse) bool {
+	if c.marketplace != "" && c.marketplace != string(subscription.GetMarketplace()) {
+		return false
+	}
+	if c.status != "" && c.status != string(subscription.GetState()) {
+		return false
+	}
+	if c.offerType != "" && c.offerType != string(subscription.GetOfferType()) {
+		return false
+	}
+	if c.assetType == "" {
+		return true
+	}
+	for _, entitlement := range subscription.GetEntitlements() {
+		asset := entitlement.GetAsset()
+		if asset.GetType() == c.assetType {
+			return true
+		}
+	}
+	return false
+}
+
+// validateSubscriptionAvailable ensures that the subscription is ACTIVE and
+// that at least one of its entitlements has quantity left to consume
+func validateSubscriptionAvailable(subscription *fabricv4.SubscriptionResponse) error {
+	if state := subscription.GetState(); state != fabricv4.SUBSCRIPTIONSTATE_ACTIVE {
+		return fmt.Errorf("marketplace subscription %q is %s, only ACTIVE subscriptions can be used", subscription.GetUuid(), state)
+	}
+	for _, entitlement := range subscription.GetEntitlements() {
+		if entitlement.GetQuantityAvailable() > 0 {
+			return nil
+		}
+	}
+	return fmt.Errorf("marketplace subscription %q has no quantity available left in its entitlements", subscription.GetUuid())
+}
+
 func subscriptionMap(subs *fabricv4.SubscriptionResponse) map[string]any {
 	subscription := make(map[string]any)
 	subscription["href"] = subs.GetHref()
@@ -57,10 +119,11 @@ func subscriptionEntitlementsGoToTerraform(entitlementsList []fabricv4.Subscript
 	for index, entitlements := range entitlementsList {
 		asset := entitlements.GetAsset()
 		mappedEntitlements[index] = map[string]any{
-			"uuid":              entitlements.GetUuid(),
-			"quantity_entitled": entitlements.GetQuantityEntitled(),
-			"quantity_consumed": entitlements.GetQuantityConsumed(),
-			"asset":             subscriptionAssetGoToTerraform(&asset),
+			"uuid":               entitlements.GetUuid(),
+			"quantity_entitled":  entitlements.GetQuantityEntitled(),
+			"quantity_consumed":  entitlements.GetQuantityConsumed(),
+			"quantity_available": entitlements.GetQuantityAvailable(),
+			"asset":              subscriptionAssetGoToTerraform(&asset),
 		}
 	}
 	return mappedEntitlements
@@ -0,0 +1,57 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/stretchr/testify/assert"
+)
+
+func testSubscription(state fabricv4.SubscriptionState, quantityAvailable int32) *fabricv4.SubscriptionResponse {
+	return &fabricv4.SubscriptionResponse{
+		Uuid:        fabricv4.PtrString("subscription-uuid"),
+		State:       state,
+		Marketplace: fabricv4.SUBSCRIPTIONRESPONSEMARKETPLACE_AWS,
+		OfferType:   fabricv4.SUBSCRIPTIONRESPONSEOFFERTYPE_PUBLIC.Ptr(),
+		Entitlements: []fabricv4.SubscriptionEntitlementResponse{
+			{
+				QuantityAvailable: fabricv4.PtrInt32(quantityAvailable),
+				Asset:             &fabricv4.SubscriptionAsset{Type: fabricv4.PtrString("XF_ROUTER")},
+			},
+		},
+	}
+}
+
+func TestMarketplaceSubscriptions_searchCriteriaMatches(t *testing.T) {
+	// given
+	subscription := testSubscription(fabricv4.SUBSCRIPTIONSTATE_ACTIVE, 1)
+	// when
+	noFilters := subscriptionSearchCriteria{}.matches(subscription)
+	allFilters := subscriptionSearchCriteria{marketplace: "AWS", status: "ACTIVE", offerType: "PUBLIC", assetType: "XF_ROUTER"}.matches(subscription)
+	otherMarketplace := subscriptionSearchCriteria{marketplace: "GCP"}.matches(subscription)
+	otherStatus := subscriptionSearchCriteria{status: "EXPIRED"}.matches(subscription)
+	otherOfferType := subscriptionSearchCriteria{offerType: "PRIVATE_OFFER"}.matches(subscription)
+	otherAssetType := subscriptionSearchCriteria{assetType: "IP_VC"}.matches(subscription)
+	// then
+	assert.True(t, noFilters, "Subscription matches empty search criteria")
+	assert.True(t, allFilters, "Subscription matches all of its own attributes")
+	assert.False(t, otherMarketplace, "Subscription from a different marketplace is filtered out")
+	assert.False(t, otherStatus, "Subscription in a different status is filtered out")
+	assert.False(t, otherOfferType, "Subscription with a different offer type is filtered out")
+	assert.False(t, otherAssetType, "Subscription without an entitlement for the asset type is filtered out")
+}
+
+func TestMarketplaceSubscriptions_validateSubscriptionAvailable(t *testing.T) {
+	// given
+	active := testSubscription(fabricv4.SUBSCRIPTIONSTATE_ACTIVE, 2)
+	expired := testSubscription(fabricv4.SUBSCRIPTIONSTATE_EXPIRED, 2)
+	consumed := testSubscription(fabricv4.SUBSCRIPTIONSTATE_ACTIVE, 0)
+	// when
+	activeErr := validateSubscriptionAvailable(active)
+	expiredErr := validateSubscriptionAvailable(expired)
+	consumedErr := validateSubscriptionAvailable(consumed)
+	// then
+	assert.Nil(t, activeErr, "Active subscription with quantity available passes validation")
+	assert.ErrorContains(t, expiredErr, "is EXPIRED", "Subscription that is not active fails validation")
+	assert.ErrorContains(t, consumedErr, "no quantity available", "Fully consumed subscription fails validation")
+}
@@ -108,6 +108,54 @@ func setNetworksData(d *schema.ResourceData, networks *fabricv4.NetworkSearchRes
 	return diags
 }
 
+// DataSourceConnections returns the schema.Resource for fetching the connections attached to a Fabric network.
+func DataSourceConnections() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFabricNetworkConnectionsRead,
+		Schema:      readFabricNetworkConnectionsSchema(),
+		Description: `Fabric V4 API compatible data resource that allow user to fetch the connections attached to a Fabric Network for a given UUID
+
+Additional documentation:
+* Getting Started: https://docs.equinix.com/fabric/multipoint-connections/multipoint-networks/
+* API: https://docs.equinix.com/api-catalog/fabricv4/#tag/Networks`,
+	}
+}
+
+func dataSourceFabricNetworkConnectionsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
+	networkID := d.Get("network_id").(string)
+	connections, _, err := client.NetworksApi.GetConnectionsByNetworkUuid(ctx, networkID).Execute()
+	if err != nil {
+		return diag.FromErr(equinix_errors.FormatFabricError(err))
+	}
+	d.SetId(networkID)
+	return setNetworkConnectionsData(d, connections)
+}
+
+// DataSourceChanges returns the schema.Resource for fetching the change history of a Fabric network.
+func DataSourceChanges() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFabricNetworkChangesRead,
+		Schema:      readFabricNetworkChangesSchema(),
+		Description: `Fabric V4 API compatible data resource that allow user to fetch the change history of a Fabric Network for a given UUID
+
+Additional documentation:
+* Getting Started: https://docs.equinix.com/fabric/multipoint-connections/multipoint-networks/
+* API: https://docs.equinix.com/api-catalog/fabricv4/#tag/Networks`,
+	}
+}
+
+func dataSourceFabricNetworkChangesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
+	networkID := d.Get("network_id").(string)
+	changes, _, err := client.NetworksApi.GetNetworkChanges(ctx, networkID).Execute()
+	if err != nil {
+		return diag.FromErr(equinix_errors.FormatFabricError(err))
+	}
+	d.SetId(networkID)
+	return setNetworkChangesData(d, changes)
+}
+
 func networkFiltersTerraformToGo(filters []any, outerOperator string) (fabricv4.NetworkFilter, error) {
 	if len(filters) == 0 {
 		return fabricv4.NetworkFilter{}, fmt.Errorf("no filters passed to filtersTerraformToGoMethod")
@@ -132,3 +132,228 @@ func readFabricNetworkSearchSchema() map[string]*schema.Schema {
 		},
 	}
 }
+
+func readFabricNetworkConnectionsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"network_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The uuid of the Fabric Network to retrieve the member connections of",
+		},
+		"pagination": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Description: "Pagination details for the returned connections",
+			Elem:        paginationSchema(),
+		},
+		"data": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "List of the connections attached to the Fabric Network",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"uuid": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Equinix-assigned connection identifier",
+					},
+					"href": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Connection URI",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Connection name",
+					},
+					"type": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: fmt.Sprintf("Connection type. One of %v", fabricv4.AllowedConnectionTypeEnumValues),
+					},
+					"state": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: fmt.Sprintf("Connection overall state. One of %v", fabricv4.AllowedConnectionStateEnumValues),
+					},
+					"bandwidth": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Connection bandwidth in Mbps",
+					},
+					"a_side": {
+						Type:        schema.TypeSet,
+						Computed:    true,
+						Description: "Access point of the requester side of the connection",
+						Elem:        networkConnectionAccessPointSchema(),
+					},
+					"z_side": {
+						Type:        schema.TypeSet,
+						Computed:    true,
+						Description: "Access point of the destination side of the connection",
+						Elem:        networkConnectionAccessPointSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func networkConnectionAccessPointSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Access point type. One of %v", fabricv4.AllowedAccessPointTypeEnumValues),
+			},
+			"port_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identifier of the port of COLO access points",
+			},
+			"virtual_device_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identifier of the virtual device of VD access points",
+			},
+			"router_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identifier of the Cloud Router of CLOUD_ROUTER access points",
+			},
+			"network_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identifier of the network of NETWORK access points",
+			},
+			"profile_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identifier of the service profile of SP access points",
+			},
+			"service_token_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identifier of the service token the connection side was created with",
+			},
+			"metro_code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Metro code of the access point location",
+			},
+		},
+	}
+}
+
+func readFabricNetworkChangesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"network_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The uuid of the Fabric Network to retrieve the change history of",
+		},
+		"pagination": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Description: "Pagination details for the returned changes",
+			Elem:        paginationSchema(),
+		},
+		"data": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "List of the changes made to the Fabric Network",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"uuid": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Uniquely identifies a change",
+					},
+					"href": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Absolute URL that returns the details of the given change",
+					},
+					"type": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: fmt.Sprintf("Type of change. One of %v", fabricv4.AllowedNetworkChangeTypeEnumValues),
+					},
+					"status": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: fmt.Sprintf("Current outcome of the change flow. One of %v", fabricv4.AllowedNetworkChangeStatusEnumValues),
+					},
+					"created_date_time": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Set when change flow starts",
+					},
+					"updated_date_time": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Set when change object is updated",
+					},
+					"operations": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Description: "Operations applied to the network by the change",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"op": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "Operation name",
+								},
+								"path": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "Path inside document leading to updated parameter",
+								},
+								"value": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "JSON encoded new value for the updated parameter",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func paginationSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"offset": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Index of the first element",
+			},
+			"limit": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of elements returned per page",
+			},
+			"total": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of elements",
+			},
+			"next": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL relative to the next page",
+			},
+			"previous": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL relative to the previous page",
+			},
+		},
+	}
+}
@@ -44,6 +44,12 @@ func TestAccDataSourceFabricNetwork_PFCR(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.equinix_fabric_networks.example", "data.0.change_log.0.created_by_email"),
 					resource.TestCheckResourceAttrSet("data.equinix_fabric_networks.example", "data.0.change_log.0.created_date_time"),
 					resource.TestCheckResourceAttrSet("data.equinix_fabric_networks.example", "data.0.operation.0.equinix_status"),
+					resource.TestCheckResourceAttr("data.equinix_fabric_network_connections.example", "data.#", "0"),
+					resource.TestCheckResourceAttr("data.equinix_fabric_network_changes.example", "data.0.type", "NETWORK_CREATION"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_network_changes.example", "data.0.uuid"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_network_changes.example", "data.0.status"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_network_changes.example", "data.0.created_date_time"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_network_changes.example", "pagination.0.total"),
 				),
 			},
 		},
@@ -67,6 +73,12 @@ func configCreateNetworkResource_PFCR() string {
 	data "equinix_fabric_network" "example"{
 		uuid = equinix_fabric_network.example.id
 	}
+	data "equinix_fabric_network_connections" "example" {
+		network_id = equinix_fabric_network.example.id
+	}
+	data "equinix_fabric_network_changes" "example" {
+		network_id = equinix_fabric_network.example.id
+	}
 	data "equinix_fabric_networks" "example" {
 		outer_operator = "AND"
 		filter {
@@ -1,8 +1,10 @@
 package network
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"time"
 
 	equinix_fabric_schema "github.com/equinix/terraform-provider-equinix/internal/fabric/schema"
 	equinix_schema "github.com/equinix/terraform-provider-equinix/internal/schema"
@@ -90,3 +92,123 @@ func getFabricNetworkUpdateRequest(network *fabricv4.Network, d *schema.Resource
 	}
 	return changeOps, nil
 }
+
+func setNetworkConnectionsData(d *schema.ResourceData, connections *fabricv4.NetworkConnections) diag.Diagnostics {
+	mappedConnections := make([]map[string]any, len(connections.GetData()))
+	for index, connection := range connections.GetData() {
+		mappedConnections[index] = networkConnectionMap(&connection)
+	}
+	pagination := connections.GetPagination()
+	err := equinix_schema.SetMap(d, map[string]any{
+		"data":       mappedConnections,
+		"pagination": paginationGoToTerraform(&pagination),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func networkConnectionMap(connection *fabricv4.Connection) map[string]any {
+	return map[string]any{
+		"uuid":      connection.GetUuid(),
+		"href":      connection.GetHref(),
+		"name":      connection.GetName(),
+		"type":      string(connection.GetType()),
+		"state":     string(connection.GetState()),
+		"bandwidth": int(connection.GetBandwidth()),
+		"a_side":    networkConnectionSideGoToTerraform(connection.GetASide()),
+		"z_side":    networkConnectionSideGoToTerraform(connection.GetZSide()),
+	}
+}
+
+func networkConnectionSideGoToTerraform(connectionSide fabricv4.ConnectionSide) *schema.Set {
+	accessPoint := connectionSide.GetAccessPoint()
+	serviceToken := connectionSide.GetServiceToken()
+	port := accessPoint.GetPort()
+	virtualDevice := accessPoint.GetVirtualDevice()
+	router := accessPoint.GetRouter()
+	network := accessPoint.GetNetwork()
+	profile := accessPoint.GetProfile()
+	location := accessPoint.GetLocation()
+	mappedAccessPoint := map[string]any{
+		"type":                string(accessPoint.GetType()),
+		"port_uuid":           port.GetUuid(),
+		"virtual_device_uuid": virtualDevice.GetUuid(),
+		"router_uuid":         router.GetUuid(),
+		"network_uuid":        network.GetUuid(),
+		"profile_uuid":        profile.GetUuid(),
+		"service_token_uuid":  serviceToken.GetUuid(),
+		"metro_code":          location.GetMetroCode(),
+	}
+	return schema.NewSet(
+		schema.HashResource(networkConnectionAccessPointSchema()),
+		[]any{mappedAccessPoint},
+	)
+}
+
+func setNetworkChangesData(d *schema.ResourceData, changes *fabricv4.NetworkChangeResponse) diag.Diagnostics {
+	mappedChanges := make([]map[string]any, len(changes.GetData()))
+	for index, change := range changes.GetData() {
+		mappedChange, err := networkChangeMap(&change)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		mappedChanges[index] = mappedChange
+	}
+	pagination := changes.GetPagination()
+	err := equinix_schema.SetMap(d, map[string]any{
+		"data":       mappedChanges,
+		"pagination": paginationGoToTerraform(&pagination),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func networkChangeMap(change *fabricv4.NetworkChange) (map[string]any, error) {
+	operations := make([]map[string]any, len(change.GetData()))
+	for index, operation := range change.GetData() {
+		value, err := json.Marshal(operation.GetValue())
+		if err != nil {
+			return nil, fmt.Errorf("error encoding value of network change %s operation %s: %w", change.GetUuid(), operation.GetPath(), err)
+		}
+		operations[index] = map[string]any{
+			"op":    string(operation.GetOp()),
+			"path":  operation.GetPath(),
+			"value": string(value),
+		}
+	}
+	mappedChange := map[string]any{
+		"uuid":       change.GetUuid(),
+		"href":       change.GetHref(),
+		"type":       string(change.GetType()),
+		"status":     string(change.GetStatus()),
+		"operations": operations,
+	}
+	if createdDateTime, ok := change.GetCreatedDateTimeOk(); ok {
+		mappedChange["created_date_time"] = createdDateTime.Format(time.RFC3339)
+	}
+	if updatedDateTime, ok := change.GetUpdatedDateTimeOk(); ok {
+		mappedChange["updated_date_time"] = updatedDateTime.Format(time.RFC3339)
+	}
+	return mappedChange, nil
+}
+
+func paginationGoToTerraform(pagination *fabricv4.Pagination) *schema.Set {
+	if pagination == nil {
+		return nil
+	}
+	mappedPagination := map[string]any{
+		"offset":   int(pagination.GetOffset()),
+		"limit":    int(pagination.GetLimit()),
+		"total":    int(pagination.GetTotal()),
+		"next":     pagination.GetNext(),
+		"previous": pagination.GetPrevious(),
+	}
+	return schema.NewSet(
+		schema.HashResource(paginationSchema()),
+		[]any{mappedPagination},
+	)
+}
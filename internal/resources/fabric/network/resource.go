@@ -83,9 +83,12 @@ func resourceFabricNetworkCreate(ctx context.Context, d *schema.ResourceData, me
 
 func resourceFabricNetworkRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
-	fabricNetwork, _, err := client.NetworksApi.GetNetworkByUuid(ctx, d.Id()).Execute()
+	fabricNetwork, httpResp, err := client.NetworksApi.GetNetworkByUuid(ctx, d.Id()).Execute()
+	if err == nil {
+		err = equinix_errors.CheckFabricDeletedState(d.Id(), string(fabricNetwork.GetState()))
+	}
 	if err != nil {
-		return diag.FromErr(equinix_errors.FormatFabricError(err))
+		return equinix_errors.FabricReadDiagnostics(d, httpResp, err)
 	}
 	d.SetId(fabricNetwork.Uuid)
 	return setFabricNetworkMap(d, fabricNetwork)
@@ -0,0 +1,74 @@
+// Package observability for Fabric metrics and events data sources
+package observability
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/fabric"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// NewDataSourceMetrics creates a new data source for the metrics of a Fabric asset
+func NewDataSourceMetrics() datasource.DataSource {
+	return &DataSourceMetrics{
+		BaseDataSource: framework.NewBaseDataSource(
+			framework.BaseDataSourceConfig{
+				Name: "equinix_fabric_metrics",
+			},
+		),
+	}
+}
+
+// DataSourceMetrics data source represents the metrics reported for a Fabric asset
+type DataSourceMetrics struct {
+	framework.BaseDataSource
+}
+
+// Schema returns the metrics data source schema
+func (r *DataSourceMetrics) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = dataSourceMetricsSchema(ctx)
+}
+
+// Read retrieves the metric of the asset over the requested time window
+func (r *DataSourceMetrics) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	client := r.Meta.NewFabricClientForFramework(ctx, request.ProviderMeta)
+
+	var data dataSourceMetricsModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	startDateTime, endDateTime, diags := fabric.ParseTimeWindow(data.StartDateTime, data.EndDateTime)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	asset := fabricv4.MetricAssetType(data.Asset.ValueString())
+	assetID := data.AssetID.ValueString()
+	name := data.Name.ValueString()
+	metrics, _, err := client.MetricsApi.GetMetricByAssetId(ctx, asset, assetID).
+		Name(name).
+		FromDateTime(startDateTime).
+		ToDateTime(endDateTime).
+		Execute()
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("api error retrieving metric %s of %s %s", name, asset, assetID), equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	response.Diagnostics.Append(data.parse(ctx, metrics.GetData())...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,99 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/fabric"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const defaultEventsLimit = 20
+
+// NewDataSourceStreamEvents creates a new data source for the events of a Fabric asset
+func NewDataSourceStreamEvents() datasource.DataSource {
+	return &DataSourceStreamEvents{
+		BaseDataSource: framework.NewBaseDataSource(
+			framework.BaseDataSourceConfig{
+				Name: "equinix_fabric_stream_events",
+			},
+		),
+	}
+}
+
+// DataSourceStreamEvents data source represents the events emitted for a Fabric asset
+type DataSourceStreamEvents struct {
+	framework.BaseDataSource
+}
+
+// Schema returns the stream events data source schema
+func (r *DataSourceStreamEvents) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = dataSourceStreamEventsSchema(ctx)
+}
+
+// Read retrieves the most recent events of the asset over the requested time
+// window, optionally only those of the requested type
+func (r *DataSourceStreamEvents) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	client := r.Meta.NewFabricClientForFramework(ctx, request.ProviderMeta)
+
+	var data dataSourceStreamEventsModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if data.Limit.IsNull() || data.Limit.IsUnknown() {
+		data.Limit = types.Int32Value(defaultEventsLimit)
+	}
+
+	startDateTime, endDateTime, diags := fabric.ParseTimeWindow(data.StartDateTime, data.EndDateTime)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	asset := fabricv4.CloudEventAssetType(data.Asset.ValueString())
+	assetID := data.AssetID.ValueString()
+	eventType := data.Type.ValueString()
+	limit := int(data.Limit.ValueInt32())
+	events := framework.Paginate(func(offset int32) ([]fabricv4.CloudEvent, bool, error) {
+		eventsResponse, _, err := client.CloudEventsApi.GetCloudEventByAssetId(ctx, asset, assetID).
+			FromDateTime(startDateTime).
+			ToDateTime(endDateTime).
+			Offset(offset).
+			Limit(framework.ListPageSize).
+			Execute()
+		if err != nil {
+			return nil, false, equinix_errors.FormatFabricError(err)
+		}
+		return eventsResponse.GetData(), framework.HasMorePages(offset, len(eventsResponse.GetData()), eventsResponse.Pagination.GetTotal()), nil
+	})
+
+	var matchingEvents []fabricv4.CloudEvent
+	for event, err := range events {
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("api error retrieving events of %s %s", asset, assetID), err.Error())
+			return
+		}
+		if eventType != "" && event.GetType() != eventType {
+			continue
+		}
+		matchingEvents = append(matchingEvents, event)
+		if len(matchingEvents) >= limit {
+			break
+		}
+	}
+
+	response.Diagnostics.Append(data.parse(ctx, matchingEvents)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,181 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/equinix/terraform-provider-equinix/internal/converters"
+	"github.com/equinix/terraform-provider-equinix/internal/fabric"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func dataSourceMetricsSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: `Fabric V4 API compatible data resource that allow user to fetch a metric reported for an Equinix Fabric asset over a time window
+
+Additional Documentation:
+* Getting Started: https://docs.equinix.com/observability/
+* API: https://docs.equinix.com/api-catalog/fabricv4/#tag/Metrics`,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttributeDefaultDescription(),
+			"asset": schema.StringAttribute{
+				Description: fmt.Sprintf("Equinix defined asset category the metric is reported for. One of %v", fabricv4.AllowedMetricAssetTypeEnumValues),
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(converters.EnumArrayToStringArray(fabricv4.AllowedMetricAssetTypeEnumValues)...),
+				},
+			},
+			"asset_id": schema.StringAttribute{
+				Description: "The uuid of the asset to retrieve the metric of",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the metric to retrieve; equinix.fabric.connection.bandwidth_rx.usage",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"start_date_time": schema.StringAttribute{
+				Description: "Start of the time window of the metric, in RFC 3339 format; 2025-01-01T00:00:00Z",
+				Required:    true,
+			},
+			"end_date_time": schema.StringAttribute{
+				Description: "End of the time window of the metric, in RFC 3339 format. Defaults to the time of the read",
+				Optional:    true,
+			},
+			"data": schema.ListNestedAttribute{
+				Description: "Metrics reported for the asset over the time window",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[metricModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Metric type",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Metric name",
+							Computed:    true,
+						},
+						"unit": schema.StringAttribute{
+							Description: "Metric unit",
+							Computed:    true,
+						},
+						"interval": schema.StringAttribute{
+							Description: "Interval of the metric datapoints, set based on the time window",
+							Computed:    true,
+						},
+						"summary": schema.StringAttribute{
+							Description: "Metric summary",
+							Computed:    true,
+						},
+						"datapoints": fabric.MetricDatapointsAttribute(ctx),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceStreamEventsSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: `Fabric V4 API compatible data resource that allow user to fetch the events emitted for an Equinix Fabric asset over a time window
+
+Additional Documentation:
+* Getting Started: https://docs.equinix.com/observability/
+* API: https://docs.equinix.com/api-catalog/fabricv4/#tag/Cloud-Events`,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttributeDefaultDescription(),
+			"asset": schema.StringAttribute{
+				Description: fmt.Sprintf("Equinix defined asset category the events are emitted for. One of %v", fabricv4.AllowedCloudEventAssetTypeEnumValues),
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(converters.EnumArrayToStringArray(fabricv4.AllowedCloudEventAssetTypeEnumValues)...),
+				},
+			},
+			"asset_id": schema.StringAttribute{
+				Description: "The uuid of the asset to retrieve the events of",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the events to retrieve; equinix.fabric.connection.state.provisioned. All event types are retrieved when not set",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"start_date_time": schema.StringAttribute{
+				Description: "Start of the time window of the events, in RFC 3339 format; 2025-01-01T00:00:00Z",
+				Required:    true,
+			},
+			"end_date_time": schema.StringAttribute{
+				Description: "End of the time window of the events, in RFC 3339 format. Defaults to the time of the read",
+				Optional:    true,
+			},
+			"limit": schema.Int32Attribute{
+				Description: "Maximum number of events returned in data. Number must be between 1 and 1000, and the default is 20",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 1000),
+				},
+			},
+			"data": schema.ListNestedAttribute{
+				Description: "Events emitted for the asset over the time window",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[streamEventModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Event identifier",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Event type",
+							Computed:    true,
+						},
+						"source": schema.StringAttribute{
+							Description: "Event source",
+							Computed:    true,
+						},
+						"subject": schema.StringAttribute{
+							Description: "Event subject, the path of the asset the event is emitted for",
+							Computed:    true,
+						},
+						"time": schema.StringAttribute{
+							Description: "Date and time the event occurred",
+							Computed:    true,
+						},
+						"severity_number": schema.StringAttribute{
+							Description: "Event severity number",
+							Computed:    true,
+						},
+						"severity_text": schema.StringAttribute{
+							Description: "Event severity text",
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Description: "Event message",
+							Computed:    true,
+						},
+						"resource_state": schema.StringAttribute{
+							Description: "State of the asset reported by the event",
+							Computed:    true,
+						},
+						"project_id": schema.StringAttribute{
+							Description: "Project the asset belongs to",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
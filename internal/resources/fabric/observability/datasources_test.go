@@ -0,0 +1,55 @@
+package observability_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/equinix/terraform-provider-equinix/internal/acceptance"
+	testinghelpers "github.com/equinix/terraform-provider-equinix/internal/fabric/testing_helpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFabricMetricsAndStreamEventsDataSources_PFCR(t *testing.T) {
+	ports := testinghelpers.GetFabricEnvPorts(t)
+	var portUUID string
+	if len(ports) > 0 {
+		portUUID = ports["pfcr"]["dot1q"][0].GetUuid()
+	}
+	startDateTime := time.Now().UTC().Add(-24 * time.Hour).Format(time.RFC3339)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFabricMetricsAndStreamEventsDataSourcesConfig(portUUID, startDateTime),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.equinix_fabric_metrics.bandwidth", "id", portUUID),
+					resource.TestCheckResourceAttr("data.equinix_fabric_metrics.bandwidth", "data.0.name", "equinix.fabric.port.bandwidth_rx.usage"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_metrics.bandwidth", "data.0.unit"),
+					resource.TestCheckResourceAttr("data.equinix_fabric_stream_events.events", "id", portUUID),
+					resource.TestCheckResourceAttr("data.equinix_fabric_stream_events.events", "limit", "5"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_stream_events.events", "data.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFabricMetricsAndStreamEventsDataSourcesConfig(portUUID, startDateTime string) string {
+	return fmt.Sprintf(`
+		data "equinix_fabric_metrics" "bandwidth" {
+			asset           = "ports"
+			asset_id        = "%[1]s"
+			name            = "equinix.fabric.port.bandwidth_rx.usage"
+			start_date_time = "%[2]s"
+		}
+
+		data "equinix_fabric_stream_events" "events" {
+			asset           = "ports"
+			asset_id        = "%[1]s"
+			start_date_time = "%[2]s"
+			limit           = 5
+		}
+	`, portUUID, startDateTime)
+}
@@ -0,0 +1,100 @@
+package observability
+
+import (
+	"context"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/equinix/terraform-provider-equinix/internal/fabric"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceMetricsModel struct {
+	ID            types.String                                 `tfsdk:"id"`
+	Asset         types.String                                 `tfsdk:"asset"`
+	AssetID       types.String                                 `tfsdk:"asset_id"`
+	Name          types.String                                 `tfsdk:"name"`
+	StartDateTime types.String                                 `tfsdk:"start_date_time"`
+	EndDateTime   types.String                                 `tfsdk:"end_date_time"`
+	Data          fwtypes.ListNestedObjectValueOf[metricModel] `tfsdk:"data"`
+}
+
+type metricModel struct {
+	Type       types.String                                                 `tfsdk:"type"`
+	Name       types.String                                                 `tfsdk:"name"`
+	Unit       types.String                                                 `tfsdk:"unit"`
+	Interval   types.String                                                 `tfsdk:"interval"`
+	Summary    types.String                                                 `tfsdk:"summary"`
+	Datapoints fwtypes.ListNestedObjectValueOf[fabric.MetricDatapointModel] `tfsdk:"datapoints"`
+}
+
+type dataSourceStreamEventsModel struct {
+	ID            types.String                                      `tfsdk:"id"`
+	Asset         types.String                                      `tfsdk:"asset"`
+	AssetID       types.String                                      `tfsdk:"asset_id"`
+	Type          types.String                                      `tfsdk:"type"`
+	StartDateTime types.String                                      `tfsdk:"start_date_time"`
+	EndDateTime   types.String                                      `tfsdk:"end_date_time"`
+	Limit         types.Int32                                       `tfsdk:"limit"`
+	Data          fwtypes.ListNestedObjectValueOf[streamEventModel] `tfsdk:"data"`
+}
+
+type streamEventModel struct {
+	ID             types.String `tfsdk:"id"`
+	Type           types.String `tfsdk:"type"`
+	Source         types.String `tfsdk:"source"`
+	Subject        types.String `tfsdk:"subject"`
+	Time           types.String `tfsdk:"time"`
+	SeverityNumber types.String `tfsdk:"severity_number"`
+	SeverityText   types.String `tfsdk:"severity_text"`
+	Message        types.String `tfsdk:"message"`
+	ResourceState  types.String `tfsdk:"resource_state"`
+	ProjectID      types.String `tfsdk:"project_id"`
+}
+
+func (m *dataSourceMetricsModel) parse(ctx context.Context, assetMetrics []fabricv4.Metric) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.ID = m.AssetID
+
+	metrics := make([]metricModel, len(assetMetrics))
+	for i, metric := range assetMetrics {
+		metrics[i] = metricModel{
+			Type:       types.StringValue(metric.GetType()),
+			Name:       types.StringValue(metric.GetName()),
+			Unit:       types.StringValue(metric.GetUnit()),
+			Interval:   types.StringValue(metric.GetInterval()),
+			Summary:    types.StringValue(metric.GetSummary()),
+			Datapoints: fabric.ParseMetricDatapoints(ctx, metric.GetDatapoints()),
+		}
+	}
+	m.Data = fwtypes.NewListNestedObjectValueOfValueSlice[metricModel](ctx, metrics)
+
+	return diags
+}
+
+func (m *dataSourceStreamEventsModel) parse(ctx context.Context, cloudEvents []fabricv4.CloudEvent) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.ID = m.AssetID
+
+	events := make([]streamEventModel, len(cloudEvents))
+	for i, event := range cloudEvents {
+		data := event.GetData()
+		resource := data.GetResource()
+		events[i] = streamEventModel{
+			ID:             types.StringValue(event.GetId()),
+			Type:           types.StringValue(event.GetType()),
+			Source:         types.StringValue(event.GetSource()),
+			Subject:        types.StringValue(event.GetSubject()),
+			Time:           types.StringValue(fabric.FormatOptionalTime(event.Time)),
+			SeverityNumber: types.StringValue(event.GetSeveritynumber()),
+			SeverityText:   types.StringValue(event.GetSeveritytext()),
+			Message:        types.StringValue(data.GetMessage()),
+			ResourceState:  types.StringValue(resource.GetState()),
+			ProjectID:      types.StringValue(event.GetEquinixproject()),
+		}
+	}
+	m.Data = fwtypes.NewListNestedObjectValueOfValueSlice[streamEventModel](ctx, events)
+
+	return diags
+}
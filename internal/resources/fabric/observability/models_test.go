@@ -0,0 +1,44 @@
+package observability
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObservability_parseStreamEvents(t *testing.T) {
+	// given
+	ctx := context.Background()
+	eventTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	model := dataSourceStreamEventsModel{AssetID: types.StringValue("connection-uuid")}
+	events := []fabricv4.CloudEvent{
+		{
+			Id:             fabricv4.PtrString("event-uuid"),
+			Type:           fabricv4.PtrString("equinix.fabric.connection.state.provisioned"),
+			Subject:        fabricv4.PtrString("/fabric/v4/connections/connection-uuid"),
+			Time:           &eventTime,
+			Equinixproject: fabricv4.PtrString("project-uuid"),
+			Data: &fabricv4.CloudEventData{
+				Message:  fabricv4.PtrString("Connection provisioned"),
+				Resource: &fabricv4.ResourceData{State: fabricv4.PtrString("PROVISIONED")},
+			},
+		},
+	}
+	// when
+	diags := model.parse(ctx, events)
+	var data []streamEventModel
+	diags.Append(model.Data.ElementsAs(ctx, &data, false)...)
+	// then
+	assert.False(t, diags.HasError(), "Events are parsed")
+	assert.Equal(t, "connection-uuid", model.ID.ValueString(), "ID is the asset uuid")
+	assert.Len(t, data, 1)
+	assert.Equal(t, "equinix.fabric.connection.state.provisioned", data[0].Type.ValueString())
+	assert.Equal(t, "2025-01-01T12:00:00.000Z", data[0].Time.ValueString())
+	assert.Equal(t, "Connection provisioned", data[0].Message.ValueString())
+	assert.Equal(t, "PROVISIONED", data[0].ResourceState.ValueString())
+	assert.Equal(t, "project-uuid", data[0].ProjectID.ValueString())
+}
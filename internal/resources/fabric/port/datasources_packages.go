@@ -0,0 +1,57 @@
+package port
+
+import (
+	"context"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// NewDataSourcePortPackages creates a new data source for Fabric port packages
+func NewDataSourcePortPackages() datasource.DataSource {
+	return &DataSourcePortPackages{
+		BaseDataSource: framework.NewBaseDataSource(
+			framework.BaseDataSourceConfig{
+				Name: "equinix_fabric_port_packages",
+			},
+		),
+	}
+}
+
+// DataSourcePortPackages data source represents the Fabric port packages
+type DataSourcePortPackages struct {
+	framework.BaseDataSource
+}
+
+// Schema returns the port packages data source schema
+func (r *DataSourcePortPackages) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = dataSourcePortPackagesSchema(ctx)
+}
+
+// Read retrieves the port packages and keeps those matching the filters
+func (r *DataSourcePortPackages) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	client := r.Meta.NewFabricClientForFramework(ctx, request.ProviderMeta)
+
+	var data dataSourcePortPackagesModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	portPackages, _, err := client.PortPackagesApi.GetPortPackages(ctx).Execute()
+	if err != nil {
+		response.Diagnostics.AddError("api error retrieving port packages", equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	response.Diagnostics.Append(data.parse(ctx, portPackages)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,215 @@
+package port
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/equinix/terraform-provider-equinix/internal/fabric"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func dataSourcePortPackagesSchema(ctx context.Context) schema.Schema {
+	sourceTypes := make([]string, len(fabricv4.AllowedPortPackageSourceTypeEnumValues))
+	for i, sourceType := range fabricv4.AllowedPortPackageSourceTypeEnumValues {
+		sourceTypes[i] = string(sourceType)
+	}
+	return schema.Schema{
+		Description: `Fabric V4 API compatible data resource that allow user to fetch the Fabric port packages available for port orders
+
+Additional Documentation:
+* Getting Started: https://docs.equinix.com/fabric/ports/fabric-order-port/
+* API: https://docs.equinix.com/api-catalog/fabricv4/#tag/Port-Packages`,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttributeDefaultDescription(),
+			"metro_code": schema.StringAttribute{
+				Description: "Metro code to only return the port packages supported in",
+				Optional:    true,
+			},
+			"source_type": schema.StringAttribute{
+				Description: fmt.Sprintf("Connectivity source type to only return the port packages supporting it. One of %v", sourceTypes),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(sourceTypes...),
+				},
+			},
+			"data": schema.ListNestedAttribute{
+				Description: "Returned list of port packages",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[portPackageModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"href": schema.StringAttribute{
+							Description: "Port package URI",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the port package",
+							Computed:    true,
+						},
+						"code": schema.StringAttribute{
+							Description: "Port package code",
+							Computed:    true,
+						},
+						"vc_bandwidth_max": schema.Int32Attribute{
+							Description: "Maximum bandwidth of the virtual connections of the port, in Mbps",
+							Computed:    true,
+						},
+						"vc_remote_supported": schema.BoolAttribute{
+							Description: "Boolean value indicating whether remote virtual connections are supported",
+							Computed:    true,
+						},
+						"supported_service_types": schema.ListAttribute{
+							Description: "Service types supported by the port package",
+							Computed:    true,
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+						},
+						"supported_source_types": schema.ListAttribute{
+							Description: "Connectivity source types supported by the port package",
+							Computed:    true,
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+						},
+						"supported_metros": schema.ListAttribute{
+							Description: "Metro codes the port package is supported in",
+							Computed:    true,
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePortStatisticsSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: `Fabric V4 API compatible data resource that allow user to fetch the operational status and metrics of a Fabric port over a time window
+
+Metrics are retrieved from the Metrics API, one request per metric name, as the port statistics endpoint of the Statistics API is deprecated. Neither API reports optical (light) levels of physical ports: operational status and error messages are returned for each physical port, and utilization, dropped and errored packets metrics for the port.
+
+Additional Documentation:
+* Getting Started: https://docs.equinix.com/fabric/ports/
+* API: https://docs.equinix.com/api-catalog/fabricv4/#tag/Metrics`,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttributeDefaultDescription(),
+			"port_id": schema.StringAttribute{
+				Description: "The uuid of the port to retrieve the statistics of",
+				Required:    true,
+			},
+			"start_date_time": schema.StringAttribute{
+				Description: "Start of the time window of the metrics, in RFC 3339 format; 2025-01-01T00:00:00Z",
+				Required:    true,
+			},
+			"end_date_time": schema.StringAttribute{
+				Description: "End of the time window of the metrics, in RFC 3339 format. Defaults to the time of the read",
+				Optional:    true,
+			},
+			"metric_names": schema.ListAttribute{
+				Description: "Names of the metrics to retrieve. Defaults to all port metrics. One of " + fabric.FormatMetricNames(fabric.PortMetricNames),
+				Optional:    true,
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(fabric.PortMetricNames...)),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "Value representing provisioning status for the port",
+				Computed:    true,
+			},
+			"operational_status": schema.StringAttribute{
+				Description: "Operational status of the port; UP, DOWN, PARTIAL",
+				Computed:    true,
+			},
+			"operational_status_changed_date_time": schema.StringAttribute{
+				Description: "Date and time at which the operational status of the port last changed",
+				Computed:    true,
+			},
+			"bandwidth": schema.Int32Attribute{
+				Description: "Port bandwidth in Mbps",
+				Computed:    true,
+			},
+			"used_bandwidth": schema.Int32Attribute{
+				Description: "Port used bandwidth in Mbps",
+				Computed:    true,
+			},
+			"available_bandwidth": schema.Int32Attribute{
+				Description: "Port available bandwidth in Mbps",
+				Computed:    true,
+			},
+			"physical_ports": schema.ListNestedAttribute{
+				Description: "Operational status of the physical ports of the port",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[physicalPortStatusModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uuid": schema.StringAttribute{
+							Description: "Equinix assigned unique identifier of the physical port",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "Value representing provisioning status for the physical port",
+							Computed:    true,
+						},
+						"operational_status": schema.StringAttribute{
+							Description: "Operational status of the physical port; UP, DOWN, PARTIAL",
+							Computed:    true,
+						},
+						"operational_status_changed_date_time": schema.StringAttribute{
+							Description: "Date and time at which the operational status of the physical port last changed",
+							Computed:    true,
+						},
+						"interface_speed": schema.Int32Attribute{
+							Description: "Physical port speed in Mbps",
+							Computed:    true,
+						},
+						"interface_type": schema.StringAttribute{
+							Description: "Physical port interface type",
+							Computed:    true,
+						},
+						"error_message": schema.StringAttribute{
+							Description: "Error reported for the physical port",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"metrics": schema.ListNestedAttribute{
+				Description: "Metrics of the port over the time window",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[portMetricModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Metric name",
+							Computed:    true,
+						},
+						"unit": schema.StringAttribute{
+							Description: "Metric unit",
+							Computed:    true,
+						},
+						"interval": schema.StringAttribute{
+							Description: "Interval of the metric datapoints, set based on the time window",
+							Computed:    true,
+						},
+						"summary": schema.StringAttribute{
+							Description: "Metric summary",
+							Computed:    true,
+						},
+						"datapoints": fabric.MetricDatapointsAttribute(ctx),
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,90 @@
+package port
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/fabric"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// NewDataSourcePortStatistics creates a new data source for Fabric port statistics
+func NewDataSourcePortStatistics() datasource.DataSource {
+	return &DataSourcePortStatistics{
+		BaseDataSource: framework.NewBaseDataSource(
+			framework.BaseDataSourceConfig{
+				Name: "equinix_fabric_port_statistics",
+			},
+		),
+	}
+}
+
+// DataSourcePortStatistics data source represents the operational status and
+// metrics of a Fabric port
+type DataSourcePortStatistics struct {
+	framework.BaseDataSource
+}
+
+// Schema returns the port statistics data source schema
+func (r *DataSourcePortStatistics) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = dataSourcePortStatisticsSchema(ctx)
+}
+
+// Read retrieves the port and its metrics over the requested time window
+func (r *DataSourcePortStatistics) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	client := r.Meta.NewFabricClientForFramework(ctx, request.ProviderMeta)
+
+	var data dataSourcePortStatisticsModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	portID := data.PortID.ValueString()
+	startDateTime, endDateTime, diags := fabric.ParseTimeWindow(data.StartDateTime, data.EndDateTime)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	metricNames := fabric.PortMetricNames
+	if !data.MetricNames.IsNull() {
+		metricNames = nil
+		response.Diagnostics.Append(data.MetricNames.ElementsAs(ctx, &metricNames, false)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	port, _, err := client.PortsApi.GetPortByUuid(ctx, portID).Execute()
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("api error retrieving port %s", portID), equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	var metrics []fabricv4.Metric
+	for _, name := range metricNames {
+		metricsResponse, _, err := client.MetricsApi.GetMetricByAssetId(ctx, fabricv4.METRICASSETTYPE_PORTS, portID).
+			Name(name).
+			FromDateTime(startDateTime).
+			ToDateTime(endDateTime).
+			Execute()
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("api error retrieving metric %s of port %s", name, portID), equinix_errors.FormatFabricError(err).Error())
+			return
+		}
+		metrics = append(metrics, metricsResponse.GetData()...)
+	}
+
+	response.Diagnostics.Append(data.parse(ctx, port, metrics)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
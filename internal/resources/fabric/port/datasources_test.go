@@ -0,0 +1,74 @@
+package port_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/equinix/terraform-provider-equinix/internal/acceptance"
+	testinghelpers "github.com/equinix/terraform-provider-equinix/internal/fabric/testing_helpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFabricPortPackagesDataSource_PFCR(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFabricPortPackagesDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_port_packages.colo", "id"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_port_packages.colo", "data.0.code"),
+					resource.TestCheckResourceAttr("data.equinix_fabric_port_packages.colo", "data.0.type", "PORT_PACKAGE"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_port_packages.colo", "data.0.vc_bandwidth_max"),
+					resource.TestCheckTypeSetElemAttr("data.equinix_fabric_port_packages.colo", "data.0.supported_source_types.*", "COLO"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFabricPortPackagesDataSourceConfig() string {
+	return `
+		data "equinix_fabric_port_packages" "colo" {
+			source_type = "COLO"
+		}
+	`
+}
+
+func TestAccFabricPortStatisticsDataSource_PFCR(t *testing.T) {
+	ports := testinghelpers.GetFabricEnvPorts(t)
+	var portUUID string
+	if len(ports) > 0 {
+		portUUID = ports["pfcr"]["dot1q"][0].GetUuid()
+	}
+	startDateTime := time.Now().UTC().Add(-24 * time.Hour).Format(time.RFC3339)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFabricPortStatisticsDataSourceConfig(portUUID, startDateTime),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.equinix_fabric_port_statistics.stats", "id", portUUID),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_port_statistics.stats", "operational_status"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_port_statistics.stats", "physical_ports.0.uuid"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_port_statistics.stats", "physical_ports.0.operational_status"),
+					resource.TestCheckResourceAttr("data.equinix_fabric_port_statistics.stats", "metrics.#", "1"),
+					resource.TestCheckResourceAttr("data.equinix_fabric_port_statistics.stats", "metrics.0.name", "equinix.fabric.port.bandwidth_rx.usage"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFabricPortStatisticsDataSourceConfig(portUUID, startDateTime string) string {
+	return fmt.Sprintf(`
+		data "equinix_fabric_port_statistics" "stats" {
+			port_id         = "%s"
+			start_date_time = "%s"
+			metric_names    = ["equinix.fabric.port.bandwidth_rx.usage"]
+		}
+	`, portUUID, startDateTime)
+}
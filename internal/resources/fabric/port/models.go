@@ -3,6 +3,7 @@ package port
 
 import (
 	"context"
+	"slices"
 
 	"github.com/equinix/equinix-sdk-go/services/fabricv4"
 	"github.com/equinix/terraform-provider-equinix/internal/fabric"
@@ -23,6 +24,7 @@ type resourceModel struct {
 type basePortModel struct {
 	Type                   types.String                                         `tfsdk:"type"`
 	Name                   types.String                                         `tfsdk:"name"`
+	WaitForActive          types.Bool                                           `tfsdk:"wait_for_active"`
 	ConnectivitySourceType types.String                                         `tfsdk:"connectivity_source_type"`
 	Location               fwtypes.ObjectValueOf[locationModel]                 `tfsdk:"location"`
 	Encapsulation          fwtypes.ObjectValueOf[encapsulationModel]            `tfsdk:"encapsulation"`
@@ -40,6 +42,10 @@ type basePortModel struct {
 	Notifications          fwtypes.ListNestedObjectValueOf[notificationModel]   `tfsdk:"notifications"`
 	AdditionalInfo         fwtypes.ListNestedObjectValueOf[additionalInfoModel] `tfsdk:"additional_info"`
 	ChangeLog              fwtypes.ObjectValueOf[changeLogModel]                `tfsdk:"change_log"`
+	OperationalStatus      types.String                                         `tfsdk:"operational_status"`
+	DemarcationPoint       fwtypes.ObjectValueOf[portDemarcationPointModel]     `tfsdk:"demarcation_point"`
+	Loas                   fwtypes.ListNestedObjectValueOf[loaModel]            `tfsdk:"loas"`
+	CrossConnects          fwtypes.ListNestedObjectValueOf[crossConnectModel]   `tfsdk:"cross_connects"`
 	Href                   types.String                                         `tfsdk:"href"`
 	UUID                   types.String                                         `tfsdk:"uuid"`
 	State                  types.String                                         `tfsdk:"state"`
@@ -96,6 +102,33 @@ type demarcationPointModel struct {
 	ConnectorType        types.String `tfsdk:"connector_type"`
 }
 
+type portDemarcationPointModel struct {
+	Ibx                  types.String `tfsdk:"ibx"`
+	CageUniqueSpaceID    types.String `tfsdk:"cage_unique_space_id"`
+	CabinetUniqueSpaceID types.String `tfsdk:"cabinet_unique_space_id"`
+	PatchPanel           types.String `tfsdk:"patch_panel"`
+	PatchPanelPortA      types.String `tfsdk:"patch_panel_port_a"`
+	PatchPanelPortB      types.String `tfsdk:"patch_panel_port_b"`
+	ConnectorType        types.String `tfsdk:"connector_type"`
+}
+
+type loaModel struct {
+	UUID types.String `tfsdk:"uuid"`
+	Type types.String `tfsdk:"type"`
+}
+
+type crossConnectModel struct {
+	PhysicalPortUUID types.String `tfsdk:"physical_port_uuid"`
+	State            types.String `tfsdk:"state"`
+	CrossConnectID   types.String `tfsdk:"cross_connect_id"`
+	Ibx              types.String `tfsdk:"ibx"`
+	SystemName       types.String `tfsdk:"system_name"`
+	CabinetNumber    types.String `tfsdk:"cabinet_number"`
+	PatchPanel       types.String `tfsdk:"patch_panel"`
+	PatchPanelPortA  types.String `tfsdk:"patch_panel_port_a"`
+	PatchPanelPortB  types.String `tfsdk:"patch_panel_port_b"`
+}
+
 type orderModel struct {
 	PurchaseOrder       fwtypes.ObjectValueOf[purchaseOrderModel] `tfsdk:"purchase_order"`
 	CustomerReferenceID types.String                              `tfsdk:"customer_reference_id"`
@@ -147,6 +180,58 @@ type changeLogModel struct {
 	DeletedDateTime   types.String `tfsdk:"deleted_date_time"`
 }
 
+type dataSourcePortPackagesModel struct {
+	ID         types.String                                      `tfsdk:"id"`
+	MetroCode  types.String                                      `tfsdk:"metro_code"`
+	SourceType types.String                                      `tfsdk:"source_type"`
+	Data       fwtypes.ListNestedObjectValueOf[portPackageModel] `tfsdk:"data"`
+}
+
+type portPackageModel struct {
+	Href                  types.String                      `tfsdk:"href"`
+	Type                  types.String                      `tfsdk:"type"`
+	Code                  types.String                      `tfsdk:"code"`
+	VcBandwidthMax        types.Int32                       `tfsdk:"vc_bandwidth_max"`
+	VcRemoteSupported     types.Bool                        `tfsdk:"vc_remote_supported"`
+	SupportedServiceTypes fwtypes.ListValueOf[types.String] `tfsdk:"supported_service_types"`
+	SupportedSourceTypes  fwtypes.ListValueOf[types.String] `tfsdk:"supported_source_types"`
+	SupportedMetros       fwtypes.ListValueOf[types.String] `tfsdk:"supported_metros"`
+}
+
+type dataSourcePortStatisticsModel struct {
+	ID                               types.String                                             `tfsdk:"id"`
+	PortID                           types.String                                             `tfsdk:"port_id"`
+	StartDateTime                    types.String                                             `tfsdk:"start_date_time"`
+	EndDateTime                      types.String                                             `tfsdk:"end_date_time"`
+	MetricNames                      fwtypes.ListValueOf[types.String]                        `tfsdk:"metric_names"`
+	State                            types.String                                             `tfsdk:"state"`
+	OperationalStatus                types.String                                             `tfsdk:"operational_status"`
+	OperationalStatusChangedDateTime types.String                                             `tfsdk:"operational_status_changed_date_time"`
+	Bandwidth                        types.Int32                                              `tfsdk:"bandwidth"`
+	UsedBandwidth                    types.Int32                                              `tfsdk:"used_bandwidth"`
+	AvailableBandwidth               types.Int32                                              `tfsdk:"available_bandwidth"`
+	PhysicalPorts                    fwtypes.ListNestedObjectValueOf[physicalPortStatusModel] `tfsdk:"physical_ports"`
+	Metrics                          fwtypes.ListNestedObjectValueOf[portMetricModel]         `tfsdk:"metrics"`
+}
+
+type physicalPortStatusModel struct {
+	UUID                             types.String `tfsdk:"uuid"`
+	State                            types.String `tfsdk:"state"`
+	OperationalStatus                types.String `tfsdk:"operational_status"`
+	OperationalStatusChangedDateTime types.String `tfsdk:"operational_status_changed_date_time"`
+	InterfaceSpeed                   types.Int32  `tfsdk:"interface_speed"`
+	InterfaceType                    types.String `tfsdk:"interface_type"`
+	ErrorMessage                     types.String `tfsdk:"error_message"`
+}
+
+type portMetricModel struct {
+	Name       types.String                                                 `tfsdk:"name"`
+	Unit       types.String                                                 `tfsdk:"unit"`
+	Interval   types.String                                                 `tfsdk:"interval"`
+	Summary    types.String                                                 `tfsdk:"summary"`
+	Datapoints fwtypes.ListNestedObjectValueOf[fabric.MetricDatapointModel] `tfsdk:"datapoints"`
+}
+
 func (m *resourceModel) parse(ctx context.Context, port *fabricv4.Port) diag.Diagnostics {
 	m.ID = types.StringValue(port.GetUuid())
 	diags := m.basePortModel.parse(ctx, port)
@@ -256,9 +341,67 @@ func (m *basePortModel) parse(ctx context.Context, port *fabricv4.Port) diag.Dia
 	}
 	m.ChangeLog = fwtypes.NewObjectValueOf[changeLogModel](ctx, &changeLog)
 
+	portOperation := port.GetOperation()
+	m.OperationalStatus = types.StringValue(string(portOperation.GetOperationalStatus()))
+
+	portDemarcationPoint := port.GetDemarcationPoint()
+	m.DemarcationPoint = fwtypes.NewObjectValueOf[portDemarcationPointModel](ctx, &portDemarcationPointModel{
+		Ibx:                  types.StringValue(portDemarcationPoint.GetIbx()),
+		CageUniqueSpaceID:    types.StringValue(portDemarcationPoint.GetCageUniqueSpaceId()),
+		CabinetUniqueSpaceID: types.StringValue(portDemarcationPoint.GetCabinetUniqueSpaceId()),
+		PatchPanel:           types.StringValue(portDemarcationPoint.GetPatchPanel()),
+		PatchPanelPortA:      types.StringValue(portDemarcationPoint.GetPatchPanelPortA()),
+		PatchPanelPortB:      types.StringValue(portDemarcationPoint.GetPatchPanelPortB()),
+		ConnectorType:        types.StringValue(portDemarcationPoint.GetConnectorType()),
+	})
+
+	m.Loas = parseLoas(ctx, port)
+	m.CrossConnects = parseCrossConnects(ctx, port.GetPhysicalPorts())
+
 	return diags
 }
 
+// parseLoas returns the letters of authorization of the port order, which are
+// reported either on the port or on its physical ports
+func parseLoas(ctx context.Context, port *fabricv4.Port) fwtypes.ListNestedObjectValueOf[loaModel] {
+	portLoas := port.GetLoas()
+	for _, physicalPort := range port.GetPhysicalPorts() {
+		portLoas = append(portLoas, physicalPort.GetLoas()...)
+	}
+	loas := make([]loaModel, 0, len(portLoas))
+	seen := make(map[string]bool, len(portLoas))
+	for _, loa := range portLoas {
+		if seen[loa.GetUuid()] {
+			continue
+		}
+		seen[loa.GetUuid()] = true
+		loas = append(loas, loaModel{
+			UUID: types.StringValue(loa.GetUuid()),
+			Type: types.StringValue(loa.GetType()),
+		})
+	}
+	return fwtypes.NewListNestedObjectValueOfValueSlice[loaModel](ctx, loas)
+}
+
+func parseCrossConnects(ctx context.Context, portPhysicalPorts []fabricv4.PhysicalPort) fwtypes.ListNestedObjectValueOf[crossConnectModel] {
+	crossConnects := make([]crossConnectModel, len(portPhysicalPorts))
+	for i, physicalPort := range portPhysicalPorts {
+		tether := physicalPort.GetTether()
+		crossConnects[i] = crossConnectModel{
+			PhysicalPortUUID: types.StringValue(physicalPort.GetUuid()),
+			State:            types.StringValue(string(physicalPort.GetState())),
+			CrossConnectID:   types.StringValue(tether.GetCrossConnectId()),
+			Ibx:              types.StringValue(tether.GetIbx()),
+			SystemName:       types.StringValue(tether.GetSystemName()),
+			CabinetNumber:    types.StringValue(tether.GetCabinetNumber()),
+			PatchPanel:       types.StringValue(tether.GetPatchPanel()),
+			PatchPanelPortA:  types.StringValue(tether.GetPatchPanelPortA()),
+			PatchPanelPortB:  types.StringValue(tether.GetPatchPanelPortB()),
+		}
+	}
+	return fwtypes.NewListNestedObjectValueOfValueSlice[crossConnectModel](ctx, crossConnects)
+}
+
 func parsePhysicalPorts(ctx context.Context, portPhysicalPorts []fabricv4.PhysicalPort) fwtypes.ListNestedObjectValueOf[physicalPortModel] {
 	physicalPorts := make([]physicalPortModel, len(portPhysicalPorts))
 	for i, portPhysicalPort := range portPhysicalPorts {
@@ -342,3 +485,91 @@ func parseAdditionalInfo(ctx context.Context, portAdditionalInfo []fabricv4.Port
 	}
 	return fwtypes.NewListNestedObjectValueOfValueSlice[additionalInfoModel](ctx, additionalInfo)
 }
+
+func (m *dataSourcePortPackagesModel) parse(ctx context.Context, portPackagesResponse *fabricv4.AllPortPackagesResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	metroCode := m.MetroCode.ValueString()
+	sourceType := fabricv4.PortPackageSourceType(m.SourceType.ValueString())
+	portPackages := make([]portPackageModel, 0, len(portPackagesResponse.GetData()))
+	for _, portPackage := range portPackagesResponse.GetData() {
+		if (metroCode != "" && len(portPackage.GetSupportedMetros()) > 0 && !slices.Contains(portPackage.GetSupportedMetros(), metroCode)) ||
+			(sourceType != "" && !slices.Contains(portPackage.GetSupportedSourceTypes(), sourceType)) {
+			continue
+		}
+		serviceTypes := make([]string, len(portPackage.GetSupportedServiceTypes()))
+		for i, serviceType := range portPackage.GetSupportedServiceTypes() {
+			serviceTypes[i] = string(serviceType)
+		}
+		sourceTypes := make([]string, len(portPackage.GetSupportedSourceTypes()))
+		for i, supportedSourceType := range portPackage.GetSupportedSourceTypes() {
+			sourceTypes[i] = string(supportedSourceType)
+		}
+		model := portPackageModel{
+			Href:              types.StringValue(portPackage.GetHref()),
+			Type:              types.StringValue(string(portPackage.GetType())),
+			Code:              types.StringValue(portPackage.GetCode()),
+			VcBandwidthMax:    types.Int32Value(portPackage.GetVcBandwidthMax()),
+			VcRemoteSupported: types.BoolValue(portPackage.GetVcRemoteSupported()),
+		}
+		var listDiags diag.Diagnostics
+		model.SupportedServiceTypes, listDiags = fwtypes.NewListValueOf[types.String](ctx, int_fw.StringSliceToAttrValue(serviceTypes))
+		diags.Append(listDiags...)
+		model.SupportedSourceTypes, listDiags = fwtypes.NewListValueOf[types.String](ctx, int_fw.StringSliceToAttrValue(sourceTypes))
+		diags.Append(listDiags...)
+		model.SupportedMetros, listDiags = fwtypes.NewListValueOf[types.String](ctx, int_fw.StringSliceToAttrValue(portPackage.GetSupportedMetros()))
+		diags.Append(listDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		portPackages = append(portPackages, model)
+	}
+	if len(portPackages) == 0 {
+		diags.AddError("no port packages found", "no port packages match the given metro code and source type, please change the filters")
+		return diags
+	}
+	m.ID = portPackages[0].Code
+	m.Data = fwtypes.NewListNestedObjectValueOfValueSlice[portPackageModel](ctx, portPackages)
+	return diags
+}
+
+func (m *dataSourcePortStatisticsModel) parse(ctx context.Context, port *fabricv4.Port, portMetrics []fabricv4.Metric) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.ID = types.StringValue(port.GetUuid())
+	m.State = types.StringValue(string(port.GetState()))
+	operation := port.GetOperation()
+	m.OperationalStatus = types.StringValue(string(operation.GetOperationalStatus()))
+	m.OperationalStatusChangedDateTime = types.StringValue(fabric.FormatOptionalTime(operation.OpStatusChangedAt))
+	m.Bandwidth = types.Int32Value(port.GetBandwidth()) //nolint:staticcheck // Deprecated in favor of physical ports speed, still the only total bandwidth reported
+	m.UsedBandwidth = types.Int32Value(port.GetUsedBandwidth())
+	m.AvailableBandwidth = types.Int32Value(port.GetAvailableBandwidth())
+
+	physicalPorts := make([]physicalPortStatusModel, len(port.GetPhysicalPorts()))
+	for i, physicalPort := range port.GetPhysicalPorts() {
+		physicalPortOperation := physicalPort.GetOperation()
+		settings := physicalPort.GetSettings()
+		physicalPorts[i] = physicalPortStatusModel{
+			UUID:                             types.StringValue(physicalPort.GetUuid()),
+			State:                            types.StringValue(string(physicalPort.GetState())),
+			OperationalStatus:                types.StringValue(string(physicalPortOperation.GetOperationalStatus())),
+			OperationalStatusChangedDateTime: types.StringValue(fabric.FormatOptionalTime(physicalPortOperation.OpStatusChangedAt)),
+			InterfaceSpeed:                   types.Int32Value(physicalPort.GetInterfaceSpeed()),
+			InterfaceType:                    types.StringValue(physicalPort.GetInterfaceType()),
+			ErrorMessage:                     types.StringValue(settings.GetErrorMessage()),
+		}
+	}
+	m.PhysicalPorts = fwtypes.NewListNestedObjectValueOfValueSlice[physicalPortStatusModel](ctx, physicalPorts)
+
+	metrics := make([]portMetricModel, len(portMetrics))
+	for i, metric := range portMetrics {
+		metrics[i] = portMetricModel{
+			Name:       types.StringValue(metric.GetName()),
+			Unit:       types.StringValue(metric.GetUnit()),
+			Interval:   types.StringValue(metric.GetInterval()),
+			Summary:    types.StringValue(metric.GetSummary()),
+			Datapoints: fabric.ParseMetricDatapoints(ctx, metric.GetDatapoints()),
+		}
+	}
+	m.Metrics = fwtypes.NewListNestedObjectValueOfValueSlice[portMetricModel](ctx, metrics)
+
+	return diags
+}
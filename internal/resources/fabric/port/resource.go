@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"math"
 	"net/http"
 	"slices"
 	"strings"
@@ -83,14 +84,22 @@ func (r *Resource) Create(
 		return
 	}
 	createWaiter := getCreateUpdateWaiter(ctx, client, port.GetUuid(), createTimeout)
+	if plan.WaitForActive.ValueBool() {
+		createWaiter = getActiveWaiter(ctx, client, port.GetUuid(), createTimeout)
+	}
 	portChecked, err := createWaiter.WaitForStateContext(ctx)
 	if err != nil {
 		_, ok := err.(*retry.NotFoundError)
-		if ok {
+		if ok && !plan.WaitForActive.ValueBool() {
 			resp.Diagnostics.AddWarning("Port Order Created but Port Reservation Not Completed", "This port will not be available for use until the order is completed. It cannot be used as an immediate dependency in a connection resource. "+
 				"Please check the order status in the Equinix Fabric portal.")
 			portChecked = port
 		} else {
+			// The port order is placed and billed, keep it in state for the
+			// resource to be tainted rather than ordered again on next apply
+			resp.Diagnostics.Append(plan.parse(ctx, port)...)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 			resp.Diagnostics.AddError(
 				fmt.Sprintf("Failed creating port %s", port.GetUuid()), err.Error())
 			return
@@ -110,6 +119,7 @@ func (r *Resource) Create(
 
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 }
 
 // Read Terraform necessary resource implementation method
@@ -131,9 +141,16 @@ func (r *Resource) Read(
 	// Extract the ID of the resource from the state
 	id := state.ID.ValueString()
 
-	port, _, err := client.PortsApi.GetPortByUuid(ctx, id).Execute()
-
+	port, httpResp, err := client.PortsApi.GetPortByUuid(ctx, id).Execute()
+	if err == nil {
+		err = equinix_errors.CheckFabricDeletedState(id, string(port.GetState()))
+	}
 	if err != nil {
+		if equinix_errors.IsFabricNotFound(httpResp, err) {
+			resp.Diagnostics.Append(equinix_errors.NewResourceNotFoundWarningDiagnostic(err))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed retrieving port %s", id), equinix_errors.FormatFabricError(err).Error())
 		return
@@ -147,6 +164,7 @@ func (r *Resource) Read(
 
 	// Update the Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 }
 
 // Update Terraform necessary resource implementation method
@@ -170,6 +188,12 @@ func (r *Resource) Update(
 	if plan.Name.ValueString() == state.Name.ValueString() {
 		resp.Diagnostics.AddWarning("No configurable values have changed",
 			"Terraform detected a config change, but it is just for a computed field(s). No update API call will be made.")
+		// Computed values are left unknown in the plan, keep the ones from
+		// state along with the changed arguments not sent to the API
+		state.WaitForActive = plan.WaitForActive
+		state.Timeouts = plan.Timeouts
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 		return
 	}
 
@@ -208,6 +232,7 @@ func (r *Resource) Update(
 
 	// Set the updated state back into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 }
 
 // Delete Terraform necessary resource implementation method
@@ -490,6 +515,27 @@ func getCreateUpdateWaiter(ctx context.Context, client *fabricv4.APIClient, id s
 	}
 }
 
+// getActiveWaiter waits for a port order to be completed, including the
+// reservation of the port and its cross connects, which can take days
+func getActiveWaiter(ctx context.Context, client *fabricv4.APIClient, id string, timeout time.Duration) *retry.StateChangeConf {
+	waiter := getCreateUpdateWaiter(ctx, client, id, timeout)
+	waiter.Pending = []string{
+		string(fabricv4.PORTSTATE_PENDING),
+		string(fabricv4.PORTSTATE_TO_BE_ADDED),
+		string(fabricv4.PORTSTATE_ADDED),
+		string(fabricv4.PORTSTATE_PENDING_CROSS_CONNECT),
+		string(fabricv4.PORTSTATE_PROVISIONING),
+	}
+	waiter.Target = []string{
+		string(fabricv4.PORTSTATE_PROVISIONED),
+		string(fabricv4.PORTSTATE_ACTIVE),
+	}
+	// Keep polling until the timeout while the port reservation is pending
+	waiter.NotFoundChecks = math.MaxInt32
+	waiter.MinTimeout = time.Minute
+	return waiter
+}
+
 func getDeleteWaiter(ctx context.Context, client *fabricv4.APIClient, id string, timeout time.Duration) *retry.StateChangeConf {
 	// deletedMarker is a terraform-provider-only value that is used by the waiter
 	// to indicate that the connection appears to be deleted successfully based on
@@ -38,6 +38,10 @@ Additional Documentation:
 				Description: "Designated name of the port",
 				Computed:    true,
 			},
+			"wait_for_active": schema.BoolAttribute{
+				Description: "Boolean value to keep waiting on create until the port order, including its cross connects, is completed and the port is active. Port orders can take days to complete, so the create timeout has to be set accordingly. Defaults to false",
+				Optional:    true,
+			},
 			"type": schema.StringAttribute{
 				Description: "Type of the port order request",
 				Required:    true,
@@ -320,6 +324,107 @@ Additional Documentation:
 					},
 				},
 			},
+			"operational_status": schema.StringAttribute{
+				Description: "Operational status of the port; UP, DOWN, PARTIAL",
+				Computed:    true,
+			},
+			"demarcation_point": schema.SingleNestedAttribute{
+				Description: "Customer side demarcation point of the port",
+				Computed:    true,
+				CustomType:  fwtypes.NewObjectTypeOf[portDemarcationPointModel](ctx),
+				Attributes: map[string]schema.Attribute{
+					"ibx": schema.StringAttribute{
+						Description: "IBX code of the demarcation point",
+						Computed:    true,
+					},
+					"cage_unique_space_id": schema.StringAttribute{
+						Description: "Cage unique space id of the demarcation point",
+						Computed:    true,
+					},
+					"cabinet_unique_space_id": schema.StringAttribute{
+						Description: "Cabinet unique space id of the demarcation point",
+						Computed:    true,
+					},
+					"patch_panel": schema.StringAttribute{
+						Description: "Patch panel of the demarcation point",
+						Computed:    true,
+					},
+					"patch_panel_port_a": schema.StringAttribute{
+						Description: "Patch panel port A of the demarcation point",
+						Computed:    true,
+					},
+					"patch_panel_port_b": schema.StringAttribute{
+						Description: "Patch panel port B of the demarcation point",
+						Computed:    true,
+					},
+					"connector_type": schema.StringAttribute{
+						Description: "Connector type of the demarcation point",
+						Computed:    true,
+					},
+				},
+			},
+			"loas": schema.ListNestedAttribute{
+				Description: "Letters of authorization issued for the cross connects of the port order",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[loaModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uuid": schema.StringAttribute{
+							Description: "Equinix assigned unique identifier of the letter of authorization document",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the letter of authorization; PATCH_PANEL_PORT_LOA, CAGE_LOA",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"cross_connects": schema.ListNestedAttribute{
+				Description: "Cross connects between the physical ports of the port order and the Equinix side",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[crossConnectModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"physical_port_uuid": schema.StringAttribute{
+							Description: "Equinix assigned unique identifier of the physical port",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "Provisioning status of the physical port",
+							Computed:    true,
+						},
+						"cross_connect_id": schema.StringAttribute{
+							Description: "Identifier of the cross connect, set once the cross connect is completed",
+							Computed:    true,
+						},
+						"ibx": schema.StringAttribute{
+							Description: "IBX code of the Equinix side of the cross connect",
+							Computed:    true,
+						},
+						"system_name": schema.StringAttribute{
+							Description: "System name of the Equinix side of the cross connect",
+							Computed:    true,
+						},
+						"cabinet_number": schema.StringAttribute{
+							Description: "Cabinet number of the Equinix side of the cross connect",
+							Computed:    true,
+						},
+						"patch_panel": schema.StringAttribute{
+							Description: "Patch panel of the Equinix side of the cross connect",
+							Computed:    true,
+						},
+						"patch_panel_port_a": schema.StringAttribute{
+							Description: "Patch panel port A of the Equinix side of the cross connect",
+							Computed:    true,
+						},
+						"patch_panel_port_b": schema.StringAttribute{
+							Description: "Patch panel port B of the Equinix side of the cross connect",
+							Computed:    true,
+						},
+					},
+				},
+			},
 			"href": schema.StringAttribute{
 				Description: "Equinix assigned URI of the port resource",
 				Computed:    true,
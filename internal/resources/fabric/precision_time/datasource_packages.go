@@ -0,0 +1,95 @@
+// Package precisiontime for EPT resources and data sources
+package precisiontime
+
+import (
+	"context"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+)
+
+// NewDataSourcePackages retrieves the precision time packages
+func NewDataSourcePackages() datasource.DataSource {
+	return &DataSourcePackages{
+		BaseDataSource: framework.NewBaseDataSource(
+			framework.BaseDataSourceConfig{
+				Name: "equinix_fabric_precision_time_packages",
+			},
+		),
+	}
+}
+
+// DataSourcePackages represents the precision time packages data source
+type DataSourcePackages struct {
+	framework.BaseDataSource
+}
+
+// Schema returns the packages data source schema
+func (r *DataSourcePackages) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = dataSourcePackagesSchema(ctx)
+}
+
+// Read retrieves the precision time packages and, when a metro code is
+// given, their prices in that metro
+func (r *DataSourcePackages) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	client := r.Meta.NewFabricClientForFramework(ctx, request.ProviderMeta)
+
+	var data dataSourcePackagesModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	packages, _, err := client.PrecisionTimeApi.GetTimeServicesPackages(ctx).Execute()
+	if err != nil {
+		response.Diagnostics.AddError("api error retrieving precision time packages", equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	var prices []fabricv4.Price
+	if metroCode := data.MetroCode.ValueString(); metroCode != "" {
+		priceSearch, _, err := client.PricesApi.SearchPrices(ctx).FilterBody(buildPackagePriceSearch(metroCode)).Execute()
+		if err != nil {
+			response.Diagnostics.AddError("api error retrieving precision time package prices", equinix_errors.FormatFabricError(err).Error())
+			return
+		}
+		prices = priceSearch.GetData()
+	}
+
+	response.Diagnostics.Append(data.parse(ctx, packages.GetData(), prices)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func buildPackagePriceSearch(metroCode string) fabricv4.FilterBody {
+	equal := fabricv4.SEARCHEXPRESSIONOPERATOR_EQUAL
+	filter := fabricv4.SearchExpression{
+		And: []fabricv4.SearchExpression{
+			{
+				Property: fabricv4.PtrString("/type"),
+				Operator: &equal,
+				Values:   []string{string(fabricv4.PRODUCTTYPE_PRECISION_TIME_PRODUCT)},
+			},
+			{
+				Property: fabricv4.PtrString("/timeService/connection/aSide/accessPoint/location/metroCode"),
+				Operator: &equal,
+				Values:   []string{metroCode},
+			},
+		},
+	}
+	return fabricv4.FilterBody{Filter: &filter}
+}
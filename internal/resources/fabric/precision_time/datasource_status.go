@@ -0,0 +1,90 @@
+// Package precisiontime for EPT resources and data sources
+package precisiontime
+
+import (
+	"context"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+)
+
+const defaultHistoryLimit = 20
+
+// NewDataSourceStatus retrieves the synchronization health of a precision time service
+func NewDataSourceStatus() datasource.DataSource {
+	return &DataSourceStatus{
+		BaseDataSource: framework.NewBaseDataSource(
+			framework.BaseDataSourceConfig{
+				Name: "equinix_fabric_precision_time_status",
+			},
+		),
+	}
+}
+
+// DataSourceStatus represents the precision time service status data source
+type DataSourceStatus struct {
+	framework.BaseDataSource
+}
+
+// Schema returns the status data source schema
+func (r *DataSourceStatus) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = dataSourceStatusSchema(ctx)
+}
+
+// Read retrieves the precision time service, the status of its connections
+// and its recent state changes
+func (r *DataSourceStatus) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	client := r.Meta.NewFabricClientForFramework(ctx, request.ProviderMeta)
+
+	var data dataSourceStatusModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if data.HistoryLimit.IsNull() || data.HistoryLimit.IsUnknown() {
+		data.HistoryLimit = types.Int32Value(defaultHistoryLimit)
+	}
+
+	eptServiceID := data.EptServiceID.ValueString()
+	ept, _, err := client.PrecisionTimeApi.GetTimeServicesById(ctx, eptServiceID).Execute()
+	if err != nil {
+		response.Diagnostics.AddError("api error retrieving ept service data", equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	connections := make([]fabricv4.Connection, 0, len(ept.GetConnections()))
+	for _, eptConnection := range ept.GetConnections() {
+		connection, _, err := client.ConnectionsApi.GetConnectionByUuid(ctx, eptConnection.GetUuid()).Execute()
+		if err != nil {
+			response.Diagnostics.AddError("api error retrieving ept service connection "+eptConnection.GetUuid(), equinix_errors.FormatFabricError(err).Error())
+			return
+		}
+		connections = append(connections, *connection)
+	}
+
+	events, _, err := client.CloudEventsApi.GetCloudEventByAssetId(ctx, fabricv4.CLOUDEVENTASSETTYPE_TIME_SERVICES, eptServiceID).
+		Offset(0).Limit(data.HistoryLimit.ValueInt32()).Execute()
+	if err != nil {
+		response.Diagnostics.AddError("api error retrieving ept service state history", equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	response.Diagnostics.Append(data.parse(ctx, ept, connections, events.GetData())...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
@@ -1,5 +1,46 @@
 // Package precisiontime_test for EPT resources and data sources tests
 package precisiontime_test
 
-// Tested in resource test because of the expense to create connection and precision time
-// resources before performing data retrieval
+import (
+	"regexp"
+	"testing"
+
+	"github.com/equinix/terraform-provider-equinix/internal/acceptance"
+	testinghelpers "github.com/equinix/terraform-provider-equinix/internal/fabric/testing_helpers"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+// The precision time service data sources are tested in resource test because
+// of the expense to create connection and precision time resources before
+// performing data retrieval
+
+func TestAccFabricPrecisionTimePackagesDataSource_PFCR(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "equinix_fabric_precision_time_packages" "ptp" {
+  type       = "PTP"
+  metro_code = "SV"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.equinix_fabric_precision_time_packages.ptp", tfjsonpath.New("id"), knownvalue.NotNull()),
+					testinghelpers.ExpectKnownAttributesAt("data.equinix_fabric_precision_time_packages.ptp",
+						tfjsonpath.New("data").AtSliceIndex(0),
+						map[string]knownvalue.Check{
+							"code":      knownvalue.StringRegexp(regexp.MustCompile("^PTP_")),
+							"type":      knownvalue.StringExact("PTP"),
+							"bandwidth": knownvalue.NotNull(),
+						}),
+				},
+			},
+		},
+	})
+}
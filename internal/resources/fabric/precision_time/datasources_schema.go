@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/equinix/terraform-provider-equinix/internal/converters"
 	"github.com/equinix/terraform-provider-equinix/internal/framework"
 	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
@@ -485,3 +486,217 @@ func getEptServiceSchema(ctx context.Context) map[string]schema.Attribute {
 		},
 	}
 }
+
+func dataSourcePackagesSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: `Fabric V4 API compatible data resource that allow user to fetch the Equinix Precision Time packages available for Precision Time Services
+Additional Documentation:
+* API: https://docs.equinix.com/api-catalog/fabricv4/#tag/Precision-Time`,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttributeDefaultDescription(),
+			"type": schema.StringAttribute{
+				Description: fmt.Sprintf("Type of Precision Time Service to only return the packages of. One of %v", fabricv4.AllowedPrecisionTimeServiceRequestTypeEnumValues),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(converters.EnumArrayToStringArray(fabricv4.AllowedPrecisionTimeServiceRequestTypeEnumValues)...),
+				},
+			},
+			"metro_code": schema.StringAttribute{
+				Description: "Metro code to retrieve the prices of the packages in. Prices are not returned when not set",
+				Optional:    true,
+			},
+			"data": schema.ListNestedAttribute{
+				Description: "Returned list of Precision Time packages",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[packageDetailsModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"href": schema.StringAttribute{
+							Description: "Precision Time package URI",
+							Computed:    true,
+						},
+						"code": schema.StringAttribute{
+							Description: "Precision Time package code, used as package.code of the equinix_fabric_precision_time_service resource",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of Precision Time Service the package is for, NTP or PTP",
+							Computed:    true,
+						},
+						"bandwidth": schema.Int32Attribute{
+							Description: "Connection bandwidth in Mbps",
+							Computed:    true,
+						},
+						"clients_per_second_max": schema.Int32Attribute{
+							Description: "Maximum number of clients that can be synchronized per second at a packet rate of 1 per second",
+							Computed:    true,
+						},
+						"redundancy_supported": schema.BoolAttribute{
+							Description: "Boolean value indicating whether redundant virtual connections are supported",
+							Computed:    true,
+						},
+						"multi_subnet_supported": schema.BoolAttribute{
+							Description: "Boolean value indicating whether multiple subnets are supported",
+							Computed:    true,
+						},
+						"accuracy_sla": schema.Int32Attribute{
+							Description: "Accuracy SLA of the package, -1 denotes that the accuracy SLA is not published",
+							Computed:    true,
+						},
+						"accuracy_sla_min": schema.Int32Attribute{
+							Description: "Typical minimum accuracy of the package",
+							Computed:    true,
+						},
+						"accuracy_sla_max": schema.Int32Attribute{
+							Description: "Typical maximum accuracy of the package",
+							Computed:    true,
+						},
+						"accuracy_sla_unit": schema.StringAttribute{
+							Description: "Unit of the accuracy SLA values",
+							Computed:    true,
+						},
+						"price": schema.SingleNestedAttribute{
+							Description: "Price of the package in the given metro",
+							Computed:    true,
+							CustomType:  fwtypes.NewObjectTypeOf[packagePriceModel](ctx),
+							Attributes: map[string]schema.Attribute{
+								"currency": schema.StringAttribute{
+									Description: "Currency of the price",
+									Computed:    true,
+								},
+								"monthly_recurring_charge": schema.Float64Attribute{
+									Description: "Monthly recurring charge of the package",
+									Computed:    true,
+								},
+								"non_recurring_charge": schema.Float64Attribute{
+									Description: "Non-recurring charge of the package",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceStatusSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: `Fabric V4 API compatible data resource that allow user to fetch the synchronization health of an Equinix Precision Time Service, its connections and its recent state changes
+Additional Documentation:
+* API: https://docs.equinix.com/api-catalog/fabricv4/#tag/Precision-Time`,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttributeDefaultDescription(),
+			"ept_service_id": schema.StringAttribute{
+				Description: "The uuid of the EPT Service this data source should retrieve the status of",
+				Required:    true,
+			},
+			"history_limit": schema.Int32Attribute{
+				Description: "Maximum number of recent state changes returned in state_history. Number must be between 1 and 100, and the default is 20",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 100),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the Precision Time Service",
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the Precision Time Service",
+				Computed:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Provisioning state of the Precision Time Service",
+				Computed:    true,
+			},
+			"package_code": schema.StringAttribute{
+				Description: "Precision Time package code of the service",
+				Computed:    true,
+			},
+			"operation": schema.SingleNestedAttribute{
+				Description: "Precision Time Service Operation",
+				Computed:    true,
+				CustomType:  fwtypes.NewObjectTypeOf[operationModel](ctx),
+				Attributes: map[string]schema.Attribute{
+					"operational_status": schema.StringAttribute{
+						Description: fmt.Sprintf("Current operational status of the Precision Time Service. One of %v", fabricv4.AllowedTimeServiceOperationOperationalStatusEnumValues),
+						Computed:    true,
+					},
+				},
+			},
+			"connections": schema.ListNestedAttribute{
+				Description: "Status of the Equinix Fabric Connections associated with the Precision Time Service",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[connectionStatusModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uuid": schema.StringAttribute{
+							Description: "Equinix Fabric Connection UUID",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the Equinix Fabric Connection",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "Provisioning state of the connection",
+							Computed:    true,
+						},
+						"equinix_status": schema.StringAttribute{
+							Description: "Connection status from the Equinix side",
+							Computed:    true,
+						},
+						"provider_status": schema.StringAttribute{
+							Description: "Connection status from the provider side",
+							Computed:    true,
+						},
+						"operational_status": schema.StringAttribute{
+							Description: "Operational status of the connection",
+							Computed:    true,
+						},
+						"operational_status_changed_at": schema.StringAttribute{
+							Description: "Time when the connection transitioned into its current operational status",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"state_history": schema.ListNestedAttribute{
+				Description: "Recent state changes of the Precision Time Service, as reported by Fabric cloud events",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[stateChangeModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"time": schema.StringAttribute{
+							Description: "Time the state change occurred",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the cloud event reporting the state change",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "State of the Precision Time Service after the change",
+							Computed:    true,
+						},
+						"operational_status": schema.StringAttribute{
+							Description: "Operational status of the Precision Time Service after the change",
+							Computed:    true,
+						},
+						"severity": schema.StringAttribute{
+							Description: "Severity of the cloud event",
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Description: "Message of the cloud event",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -4,8 +4,10 @@ package precisiontime
 import (
 	"context"
 	"reflect"
+	"strings"
 
 	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/equinix/terraform-provider-equinix/internal/fabric"
 	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -300,20 +302,12 @@ func parsePackage(ctx context.Context, packageEpt fabricv4.PrecisionTimePackageP
 	return fwtypes.NewObjectValueOf[packageModel](ctx, &result), diags
 }
 
-func parseOperation(ctx context.Context, operation any) (fwtypes.ObjectValueOf[operationModel], diag.Diagnostics) {
+func parseOperation(ctx context.Context, operation fabricv4.TimeServiceOperation) (fwtypes.ObjectValueOf[operationModel], diag.Diagnostics) {
 	diags := diag.Diagnostics{}
 	result := operationModel{}
 
-	// Handle nil operation
-	if operation == nil {
-		return fwtypes.NewObjectValueOfNull[operationModel](ctx), diags
-	}
-
-	// Try to cast to expected type or extract operational status
-	if op, ok := operation.(interface{ GetOperationalStatus() string }); ok {
-		if op.GetOperationalStatus() != "" {
-			result.OperationalStatus = types.StringValue(op.GetOperationalStatus())
-		}
+	if operationalStatus, ok := operation.GetOperationalStatusOk(); ok {
+		result.OperationalStatus = types.StringValue(string(*operationalStatus))
 	}
 
 	return fwtypes.NewObjectValueOf[operationModel](ctx, &result), diags
@@ -558,3 +552,181 @@ func parseCharges(ctx context.Context, charges []fabricv4.PriceCharge) (fwtypes.
 	}
 	return fwtypes.NewListNestedObjectValueOfValueSlice(ctx, chargesModels), nil
 }
+
+type dataSourcePackagesModel struct {
+	ID        types.String                                         `tfsdk:"id"`
+	Type      types.String                                         `tfsdk:"type"`
+	MetroCode types.String                                         `tfsdk:"metro_code"`
+	Data      fwtypes.ListNestedObjectValueOf[packageDetailsModel] `tfsdk:"data"`
+}
+
+type packageDetailsModel struct {
+	Href                 types.String                             `tfsdk:"href"`
+	Code                 types.String                             `tfsdk:"code"`
+	Type                 types.String                             `tfsdk:"type"`
+	Bandwidth            types.Int32                              `tfsdk:"bandwidth"`
+	ClientsPerSecondMax  types.Int32                              `tfsdk:"clients_per_second_max"`
+	RedundancySupported  types.Bool                               `tfsdk:"redundancy_supported"`
+	MultiSubnetSupported types.Bool                               `tfsdk:"multi_subnet_supported"`
+	AccuracySla          types.Int32                              `tfsdk:"accuracy_sla"`
+	AccuracySlaMin       types.Int32                              `tfsdk:"accuracy_sla_min"`
+	AccuracySlaMax       types.Int32                              `tfsdk:"accuracy_sla_max"`
+	AccuracySlaUnit      types.String                             `tfsdk:"accuracy_sla_unit"`
+	Price                fwtypes.ObjectValueOf[packagePriceModel] `tfsdk:"price"`
+}
+
+type packagePriceModel struct {
+	Currency               types.String  `tfsdk:"currency"`
+	MonthlyRecurringCharge types.Float64 `tfsdk:"monthly_recurring_charge"`
+	NonRecurringCharge     types.Float64 `tfsdk:"non_recurring_charge"`
+}
+
+type dataSourceStatusModel struct {
+	ID           types.String                                           `tfsdk:"id"`
+	EptServiceID types.String                                           `tfsdk:"ept_service_id"`
+	HistoryLimit types.Int32                                            `tfsdk:"history_limit"`
+	Name         types.String                                           `tfsdk:"name"`
+	Type         types.String                                           `tfsdk:"type"`
+	State        types.String                                           `tfsdk:"state"`
+	PackageCode  types.String                                           `tfsdk:"package_code"`
+	Operation    fwtypes.ObjectValueOf[operationModel]                  `tfsdk:"operation"`
+	Connections  fwtypes.ListNestedObjectValueOf[connectionStatusModel] `tfsdk:"connections"`
+	StateHistory fwtypes.ListNestedObjectValueOf[stateChangeModel]      `tfsdk:"state_history"`
+}
+
+type connectionStatusModel struct {
+	UUID                       types.String `tfsdk:"uuid"`
+	Type                       types.String `tfsdk:"type"`
+	State                      types.String `tfsdk:"state"`
+	EquinixStatus              types.String `tfsdk:"equinix_status"`
+	ProviderStatus             types.String `tfsdk:"provider_status"`
+	OperationalStatus          types.String `tfsdk:"operational_status"`
+	OperationalStatusChangedAt types.String `tfsdk:"operational_status_changed_at"`
+}
+
+type stateChangeModel struct {
+	Time              types.String `tfsdk:"time"`
+	Type              types.String `tfsdk:"type"`
+	State             types.String `tfsdk:"state"`
+	OperationalStatus types.String `tfsdk:"operational_status"`
+	Severity          types.String `tfsdk:"severity"`
+	Message           types.String `tfsdk:"message"`
+}
+
+// packageServiceType returns the type of Precision Time Service, NTP or PTP,
+// a package code is for
+func packageServiceType(code string) string {
+	serviceType, _, _ := strings.Cut(code, "_")
+	return serviceType
+}
+
+func (m *dataSourcePackagesModel) parse(ctx context.Context, packages []fabricv4.PrecisionTimePackageResponse, prices []fabricv4.Price) diag.Diagnostics {
+	var diags diag.Diagnostics
+	pricesByCode := make(map[string]fabricv4.Price, len(prices))
+	for _, price := range prices {
+		timeServicePrice := price.GetTimeService()
+		packageRequest := timeServicePrice.GetPackage()
+		pricesByCode[string(packageRequest.GetCode())] = price
+	}
+
+	serviceType := m.Type.ValueString()
+	packageModels := make([]packageDetailsModel, 0, len(packages))
+	for _, timePackage := range packages {
+		code := string(timePackage.GetCode())
+		if serviceType != "" && packageServiceType(code) != serviceType {
+			continue
+		}
+		model := packageDetailsModel{
+			Href:                 types.StringValue(timePackage.GetHref()),
+			Code:                 types.StringValue(code),
+			Type:                 types.StringValue(packageServiceType(code)),
+			Bandwidth:            types.Int32Value(timePackage.GetBandwidth()),
+			ClientsPerSecondMax:  types.Int32PointerValue(timePackage.ClientsPerSecondMax),
+			RedundancySupported:  types.BoolPointerValue(timePackage.RedundancySupported),
+			MultiSubnetSupported: types.BoolPointerValue(timePackage.MultiSubnetSupported),
+			AccuracySla:          types.Int32PointerValue(timePackage.AccuracySla),
+			AccuracySlaMin:       types.Int32PointerValue(timePackage.AccuracySlaMin),
+			AccuracySlaMax:       types.Int32PointerValue(timePackage.AccuracySlaMax),
+			AccuracySlaUnit:      types.StringPointerValue(timePackage.AccuracySlaUnit),
+			Price:                fwtypes.NewObjectValueOfNull[packagePriceModel](ctx),
+		}
+		if price, ok := pricesByCode[code]; ok {
+			model.Price = parsePackagePrice(ctx, price)
+		}
+		packageModels = append(packageModels, model)
+	}
+	if len(packageModels) == 0 {
+		diags.AddError("no precision time packages found", "no precision time packages match the given type, please change the filters")
+		return diags
+	}
+	m.ID = packageModels[0].Code
+	m.Data = fwtypes.NewListNestedObjectValueOfValueSlice[packageDetailsModel](ctx, packageModels)
+	return diags
+}
+
+func parsePackagePrice(ctx context.Context, price fabricv4.Price) fwtypes.ObjectValueOf[packagePriceModel] {
+	result := packagePriceModel{
+		Currency: types.StringPointerValue(price.Currency),
+	}
+	for _, charge := range price.GetCharges() {
+		switch charge.GetType() {
+		case fabricv4.PRICECHARGETYPE_MONTHLY_RECURRING:
+			result.MonthlyRecurringCharge = types.Float64PointerValue(charge.Price)
+		case fabricv4.PRICECHARGETYPE_NON_RECURRING:
+			result.NonRecurringCharge = types.Float64PointerValue(charge.Price)
+		}
+	}
+	return fwtypes.NewObjectValueOf[packagePriceModel](ctx, &result)
+}
+
+func (m *dataSourceStatusModel) parse(ctx context.Context, ept *fabricv4.PrecisionTimeServiceResponse, connections []fabricv4.Connection, events []fabricv4.CloudEvent) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.ID = types.StringValue(ept.GetUuid())
+	m.Name = types.StringValue(ept.GetName())
+	m.Type = types.StringValue(string(ept.GetType()))
+	m.State = types.StringValue(string(ept.GetState()))
+	eptPackage := ept.GetPackage()
+	m.PackageCode = types.StringValue(string(eptPackage.GetCode()))
+	m.Operation, diags = parseOperation(ctx, ept.GetOperation())
+	if diags.HasError() {
+		return diags
+	}
+
+	connectionModels := make([]connectionStatusModel, len(connections))
+	for i, connection := range connections {
+		operation := connection.GetOperation()
+		connectionModels[i] = connectionStatusModel{
+			UUID:                       types.StringValue(connection.GetUuid()),
+			Type:                       types.StringValue(string(connection.GetType())),
+			State:                      types.StringValue(string(connection.GetState())),
+			EquinixStatus:              types.StringPointerValue((*string)(operation.EquinixStatus)),
+			ProviderStatus:             types.StringPointerValue((*string)(operation.ProviderStatus)),
+			OperationalStatus:          types.StringPointerValue((*string)(operation.OperationalStatus)),
+			OperationalStatusChangedAt: types.StringNull(),
+		}
+		if changedAt, ok := operation.GetOpStatusChangedAtOk(); ok {
+			connectionModels[i].OperationalStatusChangedAt = types.StringValue(changedAt.Format(fabric.TimeFormat))
+		}
+	}
+	m.Connections = fwtypes.NewListNestedObjectValueOfValueSlice[connectionStatusModel](ctx, connectionModels)
+
+	stateChanges := make([]stateChangeModel, len(events))
+	for i, event := range events {
+		data := event.GetData()
+		resource := data.GetResource()
+		resourceOperation := resource.GetOperation()
+		stateChanges[i] = stateChangeModel{
+			Time:              types.StringNull(),
+			Type:              types.StringPointerValue(event.Type),
+			State:             types.StringPointerValue(resource.State),
+			OperationalStatus: types.StringPointerValue(resourceOperation.OperationalStatus),
+			Severity:          types.StringPointerValue(event.Severitytext),
+			Message:           types.StringPointerValue(data.Message),
+		}
+		if eventTime, ok := event.GetTimeOk(); ok {
+			stateChanges[i].Time = types.StringValue(eventTime.Format(fabric.TimeFormat))
+		}
+	}
+	m.StateHistory = fwtypes.NewListNestedObjectValueOfValueSlice[stateChangeModel](ctx, stateChanges)
+	return diags
+}
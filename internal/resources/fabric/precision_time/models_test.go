@@ -0,0 +1,65 @@
+package precisiontime
+
+import (
+	"context"
+	"testing"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrecisionTime_parsePackages(t *testing.T) {
+	// given
+	ctx := context.Background()
+	packages := []fabricv4.PrecisionTimePackageResponse{
+		{Code: fabricv4.GETTIMESERVICESPACKAGEBYCODEPACKAGECODEPARAMETER_NTP_STANDARD, Bandwidth: 1},
+		{Code: fabricv4.GETTIMESERVICESPACKAGEBYCODEPACKAGECODEPARAMETER_PTP_STANDARD, Bandwidth: 5, AccuracySla: fabricv4.PtrInt32(-1)},
+		{Code: fabricv4.GETTIMESERVICESPACKAGEBYCODEPACKAGECODEPARAMETER_PTP_ENTERPRISE, Bandwidth: 10},
+	}
+	monthly, nonRecurring := fabricv4.PRICECHARGETYPE_MONTHLY_RECURRING, fabricv4.PRICECHARGETYPE_NON_RECURRING
+	prices := []fabricv4.Price{{
+		Currency: fabricv4.PtrString("USD"),
+		Charges: []fabricv4.PriceCharge{
+			{Type: &monthly, Price: fabricv4.PtrFloat64(200)},
+			{Type: &nonRecurring, Price: fabricv4.PtrFloat64(0)},
+		},
+		TimeService: &fabricv4.TimeServicePrice{
+			Package: &fabricv4.PrecisionTimePackageRequest{Code: fabricv4.PRECISIONTIMEPACKAGEREQUESTCODE_PTP_STANDARD},
+		},
+	}}
+	model := dataSourcePackagesModel{Type: types.StringValue("PTP")}
+
+	// when
+	diags := model.parse(ctx, packages, prices)
+
+	// then
+	require.False(t, diags.HasError(), "Parsing packages does not return errors")
+	result, diags := model.Data.ToSlice(ctx)
+	require.False(t, diags.HasError())
+	require.Len(t, result, 2, "Only packages of the given type are returned")
+	assert.Equal(t, "PTP_STANDARD", result[0].Code.ValueString(), "Package code matches")
+	assert.Equal(t, "PTP", result[0].Type.ValueString(), "Package type is derived from the code")
+	assert.Equal(t, int32(-1), result[0].AccuracySla.ValueInt32(), "Accuracy SLA matches")
+	price, diags := result[0].Price.ToPtr(ctx)
+	require.False(t, diags.HasError())
+	assert.Equal(t, "USD", price.Currency.ValueString(), "Currency matches")
+	assert.Equal(t, float64(200), price.MonthlyRecurringCharge.ValueFloat64(), "Monthly recurring charge matches")
+	assert.True(t, result[1].Price.IsNull(), "Packages without a price have a null price")
+	assert.Equal(t, "PTP_STANDARD", model.ID.ValueString(), "ID is the first package code")
+}
+
+func TestPrecisionTime_parsePackagesNoMatch(t *testing.T) {
+	// given
+	packages := []fabricv4.PrecisionTimePackageResponse{
+		{Code: fabricv4.GETTIMESERVICESPACKAGEBYCODEPACKAGECODEPARAMETER_NTP_STANDARD},
+	}
+	model := dataSourcePackagesModel{Type: types.StringValue("PTP")}
+
+	// when
+	diags := model.parse(context.Background(), packages, nil)
+
+	// then
+	assert.True(t, diags.HasError(), "No matching package returns an error")
+}
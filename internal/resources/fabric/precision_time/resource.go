@@ -90,6 +90,7 @@ func (r *Resource) Create(
 
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 }
 
 // Read retrieves precision time service
@@ -111,8 +112,16 @@ func (r *Resource) Read(
 	// Extract the ID of the resource from the state
 	id := state.ID.ValueString()
 
-	ept, _, err := client.PrecisionTimeApi.GetTimeServicesById(ctx, id).Execute()
+	ept, httpResp, err := client.PrecisionTimeApi.GetTimeServicesById(ctx, id).Execute()
+	if err == nil {
+		err = equinix_errors.CheckFabricDeletedState(id, string(ept.GetState()))
+	}
 	if err != nil {
+		if equinix_errors.IsFabricNotFound(httpResp, err) {
+			resp.Diagnostics.Append(equinix_errors.NewResourceNotFoundWarningDiagnostic(err))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed retrieving Precision Time Service %s", id), err.Error())
 		return
@@ -126,6 +135,7 @@ func (r *Resource) Read(
 
 	// Update the Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 }
 
 // Update modifies an existing precision time service
@@ -195,6 +205,7 @@ func (r *Resource) Update(
 
 	// Set the updated state back into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 }
 
 // Delete removes the precision time service
@@ -97,6 +97,15 @@ func TestAccFabricCreatePort2EPT_NTPConfiguration_PFCR(t *testing.T) {
 					newConnectionId.AddStateValue("equinix_fabric_connection.test", tfjsonpath.New("uuid")),
 					newConnectionId.AddStateValue("equinix_fabric_precision_time_service.ntp", tfjsonpath.New("connections").AtSliceIndex(0).AtMapKey("uuid")),
 					newConnectionId.AddStateValue("data.equinix_fabric_precision_time_service.ntp", tfjsonpath.New("connections").AtSliceIndex(0).AtMapKey("uuid")),
+
+					testinghelpers.ExpectKnownAttributes("data.equinix_fabric_precision_time_status.ntp", map[string]knownvalue.Check{
+						"id":            knownvalue.NotNull(),
+						"name":          knownvalue.StringExact("tf_acc_eptntp_PFCR"),
+						"package_code":  knownvalue.StringExact("NTP_STANDARD"),
+						"history_limit": knownvalue.Int32Exact(20),
+						"state_history": knownvalue.NotNull(),
+					}),
+					newConnectionId.AddStateValue("data.equinix_fabric_precision_time_status.ntp", tfjsonpath.New("connections").AtSliceIndex(0).AtMapKey("uuid")),
 				},
 				ExpectNonEmptyPlan: true,
 			},
@@ -183,6 +192,10 @@ resource "equinix_fabric_precision_time_service" "ntp" {
 data "equinix_fabric_precision_time_service" "ntp" {
   ept_service_id = equinix_fabric_precision_time_service.ntp.id
 }
+
+data "equinix_fabric_precision_time_status" "ntp" {
+  ept_service_id = equinix_fabric_precision_time_service.ntp.id
+}
 `
 
 func TestAccFabricCreatePort2EPT_PTPConfiguration_PFCR(t *testing.T) {
@@ -0,0 +1,149 @@
+package route_filter
+
+import (
+	"context"
+	"fmt"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listStates are the route filter states listed when no states are given,
+// which leave out deprovisioned route filters
+var listStates = []string{
+	string(fabricv4.ROUTEFILTERSTATE_PROVISIONING),
+	string(fabricv4.ROUTEFILTERSTATE_REPROVISIONING),
+	string(fabricv4.ROUTEFILTERSTATE_DEPROVISIONING),
+	string(fabricv4.ROUTEFILTERSTATE_PROVISIONED),
+	string(fabricv4.ROUTEFILTERSTATE_NOT_PROVISIONED),
+	string(fabricv4.ROUTEFILTERSTATE_NOT_DEPROVISIONED),
+}
+
+// NewListResource creates a list resource for Fabric route filters
+func NewListResource() list.ListResource {
+	return &ListResource{
+		SDKv2ListResource: framework.NewSDKv2ListResource(
+			framework.BaseListResourceConfig{
+				Name: "equinix_fabric_route_filter",
+			},
+			Resource(),
+		),
+	}
+}
+
+// ListResource lists Fabric route filters matching the given filters
+type ListResource struct {
+	framework.SDKv2ListResource
+}
+
+type listResourceModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	Name      types.String `tfsdk:"name"`
+	States    types.List   `tfsdk:"states"`
+}
+
+// ListResourceConfigSchema returns the schema of the list block filters
+func (r *ListResource) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	stateValues := make([]string, len(fabricv4.AllowedRouteFilterStateEnumValues))
+	for i, state := range fabricv4.AllowedRouteFilterStateEnumValues {
+		stateValues[i] = string(state)
+	}
+	resp.Schema = schema.Schema{
+		Description: "Lists the Fabric route filters matching the given filters",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "Project ID the route filters belong to",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the route filters",
+				Optional:    true,
+			},
+			"states": schema.ListAttribute{
+				Description: fmt.Sprintf("States of the route filters. Defaults to all states except DEPROVISIONED. One of %v", stateValues),
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(stateValues...)),
+				},
+			},
+		},
+	}
+}
+
+// List searches the route filters matching the filters, one page at a time
+func (r *ListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var filters listResourceModel
+	diags := req.Config.Get(ctx, &filters)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	states := listStates
+	if !filters.States.IsNull() {
+		states = nil
+		diags.Append(filters.States.ElementsAs(ctx, &states, false)...)
+		if diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	expressions := []fabricv4.SearchFilterExpression{
+		listExpression("/state", fabricv4.SEARCHSIMPLEEXPRESSIONOPERATOR_IN, states...),
+	}
+	if projectID := filters.ProjectID.ValueString(); projectID != "" {
+		expressions = append(expressions, listExpression("/project/projectId", fabricv4.SEARCHSIMPLEEXPRESSIONOPERATOR_EQUAL, projectID))
+	}
+	if name := filters.Name.ValueString(); name != "" {
+		expressions = append(expressions, listExpression("/name", fabricv4.SEARCHSIMPLEEXPRESSIONOPERATOR_EQUAL, name))
+	}
+	filter := fabricv4.SearchFilter{
+		SearchAndExpression: &fabricv4.SearchAndExpression{And: expressions},
+	}
+
+	client := r.Meta.NewFabricClientForList(ctx)
+	items := framework.Paginate(func(offset int32) ([]framework.ListItem, bool, error) {
+		pagination := fabricv4.PaginationRequest{}
+		pagination.SetOffset(offset)
+		pagination.SetLimit(framework.ListPageSize)
+		searchRequest := fabricv4.RouteFiltersSearchRequest{}
+		searchRequest.SetFilter(filter)
+		searchRequest.SetPagination(pagination)
+		routeFilters, _, err := client.RouteFiltersApi.SearchRouteFilters(ctx).RouteFiltersSearchRequest(searchRequest).Execute()
+		if err != nil {
+			return nil, false, equinix_errors.FormatFabricError(err)
+		}
+		items := make([]framework.ListItem, len(routeFilters.GetData()))
+		for i, routeFilter := range routeFilters.GetData() {
+			items[i] = framework.ListItem{ID: routeFilter.GetUuid(), DisplayName: routeFilter.GetName()}
+		}
+		return items, framework.HasMorePages(offset, len(items), routeFilters.Pagination.GetTotal()), nil
+	})
+	stream.Results = r.Results(ctx, req, items)
+}
+
+func listExpression(property string, operator fabricv4.SearchSimpleExpressionOperator, values ...string) fabricv4.SearchFilterExpression {
+	expression := fabricv4.SearchSimpleExpression{}
+	expression.SetProperty(property)
+	expression.SetOperator(operator)
+	expression.SetValues(values)
+	return fabricv4.SearchFilterExpression{SearchSimpleExpression: &expression}
+}
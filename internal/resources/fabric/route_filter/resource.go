@@ -3,11 +3,11 @@ package route_filter
 import (
 	"context"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/equinix/terraform-provider-equinix/internal/config"
 	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/importer"
 
 	"github.com/equinix/equinix-sdk-go/services/fabricv4"
 
@@ -29,10 +29,9 @@ func Resource() *schema.Resource {
 		CreateContext: resourceCreate,
 		UpdateContext: resourceUpdate,
 		DeleteContext: resourceDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Schema: resourceSchema(),
+		Importer:      importer.UUIDImporter(),
+		Identity:      importer.UUIDIdentity(),
+		Schema:        resourceSchema(),
 		Description: `Fabric V4 API compatible resource allows creation and management of Equinix Fabric Route Filter Policy
 
 Additional Documentation:
@@ -43,16 +42,21 @@ Additional Documentation:
 
 func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
-	routeFilter, _, err := client.RouteFiltersApi.GetRouteFilterByUuid(ctx, d.Id()).Execute()
+	routeFilter, httpResp, err := client.RouteFiltersApi.GetRouteFilterByUuid(ctx, d.Id()).Execute()
+	if err == nil {
+		err = equinix_errors.CheckFabricDeletedState(d.Id(), string(routeFilter.GetState()))
+	}
 	if err != nil {
-		log.Printf("[WARN] Route Filter %s not found , error %s", d.Id(), err)
-		if !strings.Contains(err.Error(), "500") {
-			d.SetId("")
-		}
-		return diag.FromErr(equinix_errors.FormatFabricError(err))
+		return equinix_errors.FabricReadDiagnostics(d, httpResp, err)
 	}
 	d.SetId(routeFilter.GetUuid())
-	return setRouteFilterMap(d, routeFilter)
+	if diags := setRouteFilterMap(d, routeFilter); diags.HasError() {
+		return diags
+	}
+	if err := importer.SetUUIDIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
 func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -3,11 +3,11 @@ package route_filter_rule
 import (
 	"context"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/equinix/terraform-provider-equinix/internal/config"
 	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/importer"
 
 	"github.com/equinix/equinix-sdk-go/services/fabricv4"
 
@@ -30,7 +30,7 @@ func Resource() *schema.Resource {
 		UpdateContext: resourceUpdate,
 		DeleteContext: resourceDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importer.StateContext("route_filter_id"),
 		},
 		Schema: resourceSchema(),
 		Description: `Fabric V4 API compatible resource allows creation and management of Equinix Fabric Route Filter Rule
@@ -44,13 +44,12 @@ Additional Documentation:
 func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
 	routeFilterID := d.Get("route_filter_id").(string)
-	routeFilterRule, _, err := client.RouteFilterRulesApi.GetRouteFilterRuleByUuid(ctx, routeFilterID, d.Id()).Execute()
+	routeFilterRule, httpResp, err := client.RouteFilterRulesApi.GetRouteFilterRuleByUuid(ctx, routeFilterID, d.Id()).Execute()
+	if err == nil {
+		err = equinix_errors.CheckFabricDeletedState(d.Id(), string(routeFilterRule.GetState()))
+	}
 	if err != nil {
-		log.Printf("[WARN] Route Filter Rule %s not found on Route Filter %s, error %s", d.Id(), routeFilterID, err)
-		if !strings.Contains(err.Error(), "500") {
-			d.SetId("")
-		}
-		return diag.FromErr(equinix_errors.FormatFabricError(err))
+		return equinix_errors.FabricReadDiagnostics(d, httpResp, err)
 	}
 	d.SetId(routeFilterRule.GetUuid())
 	return setRouteFilterRuleMap(d, routeFilterRule)
@@ -79,6 +79,7 @@ func (r *Resource) Create(
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -100,8 +101,16 @@ func (r *Resource) Read(
 	// Extract the ID of the resource from the state
 	id := state.ID.ValueString()
 
-	routeAggregation, _, err := client.RouteAggregationsApi.GetRouteAggregationByUuid(ctx, id).Execute()
+	routeAggregation, httpResp, err := client.RouteAggregationsApi.GetRouteAggregationByUuid(ctx, id).Execute()
+	if err == nil {
+		err = equinix_errors.CheckFabricDeletedState(id, string(routeAggregation.GetState()))
+	}
 	if err != nil {
+		if equinix_errors.IsFabricNotFound(httpResp, err) {
+			resp.Diagnostics.Append(equinix_errors.NewResourceNotFoundWarningDiagnostic(err))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed retrieving Route Aggregation %s", id), equinix_errors.FormatFabricError(err).Error())
 		return
@@ -115,6 +124,7 @@ func (r *Resource) Read(
 
 	// Update the Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -177,6 +187,7 @@ func (r *Resource) Update(
 
 	//Set the updated state back into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *Resource) Delete(
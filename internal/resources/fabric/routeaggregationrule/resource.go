@@ -19,7 +19,8 @@ func NewResource() resource.Resource {
 	return &Resource{
 		BaseResource: framework.NewBaseResource(
 			framework.BaseResourceConfig{
-				Name: "equinix_fabric_route_aggregation_rule",
+				Name:          "equinix_fabric_route_aggregation_rule",
+				ParentIDAttrs: []string{"route_aggregation_id"},
 			},
 		),
 	}
@@ -79,6 +80,7 @@ func (r *Resource) Create(
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *Resource) Read(
@@ -99,8 +101,16 @@ func (r *Resource) Read(
 	id := state.ID.ValueString()
 	routeAggregationID := state.RouteAggregationID.ValueString()
 
-	routeAggregationRule, _, err := client.RouteAggregationRulesApi.GetRouteAggregationRuleByUuid(ctx, routeAggregationID, id).Execute()
+	routeAggregationRule, httpResp, err := client.RouteAggregationRulesApi.GetRouteAggregationRuleByUuid(ctx, routeAggregationID, id).Execute()
+	if err == nil {
+		err = equinix_errors.CheckFabricDeletedState(id, string(routeAggregationRule.GetState()))
+	}
 	if err != nil {
+		if equinix_errors.IsFabricNotFound(httpResp, err) {
+			resp.Diagnostics.Append(equinix_errors.NewResourceNotFoundWarningDiagnostic(err))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed retrieving Route Aggregation Rule %s", id), equinix_errors.FormatFabricError(err).Error())
 		return
@@ -113,6 +123,7 @@ func (r *Resource) Read(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *Resource) Update(
@@ -172,6 +183,7 @@ func (r *Resource) Update(
 
 	//Set the updated state back into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *Resource) Delete(
@@ -7,6 +7,9 @@ import (
 
 func dataSourceBaseSchema() map[string]*schema.Schema {
 	sch := resourceSchema()
+	delete(sch, "auto_extend")
+	delete(sch, "resend_notification_triggers")
+	sch["expiration_date_time"].ExactlyOneOf = nil
 	for key := range sch {
 		if key == "uuid" {
 			sch[key].Required = true
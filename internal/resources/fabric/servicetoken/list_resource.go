@@ -0,0 +1,145 @@
+package servicetoken
+
+import (
+	"context"
+	"fmt"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listStates are the service token states listed when no states are given,
+// which leave out deleted service tokens
+var listStates = []string{
+	string(fabricv4.SERVICETOKENSTATE_ACTIVE),
+	string(fabricv4.SERVICETOKENSTATE_INACTIVE),
+	string(fabricv4.SERVICETOKENSTATE_EXPIRED),
+}
+
+// NewListResource creates a list resource for Fabric service tokens
+func NewListResource() list.ListResource {
+	return &ListResource{
+		SDKv2ListResource: framework.NewSDKv2ListResource(
+			framework.BaseListResourceConfig{
+				Name: "equinix_fabric_service_token",
+			},
+			Resource(),
+		),
+	}
+}
+
+// ListResource lists Fabric service tokens matching the given filters
+type ListResource struct {
+	framework.SDKv2ListResource
+}
+
+type listResourceModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	Name      types.String `tfsdk:"name"`
+	States    types.List   `tfsdk:"states"`
+}
+
+// ListResourceConfigSchema returns the schema of the list block filters
+func (r *ListResource) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	stateValues := make([]string, len(fabricv4.AllowedServiceTokenStateEnumValues))
+	for i, state := range fabricv4.AllowedServiceTokenStateEnumValues {
+		stateValues[i] = string(state)
+	}
+	resp.Schema = schema.Schema{
+		Description: "Lists the Fabric service tokens matching the given filters",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "Project ID the service tokens belong to",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the service tokens",
+				Optional:    true,
+			},
+			"states": schema.ListAttribute{
+				Description: fmt.Sprintf("States of the service tokens. Defaults to all states except DELETED. One of %v", stateValues),
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(stateValues...)),
+				},
+			},
+		},
+	}
+}
+
+// List searches the service tokens matching the filters, one page at a time
+func (r *ListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var filters listResourceModel
+	diags := req.Config.Get(ctx, &filters)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	states := listStates
+	if !filters.States.IsNull() {
+		states = nil
+		diags.Append(filters.States.ElementsAs(ctx, &states, false)...)
+		if diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	expressions := []fabricv4.ServiceTokenSearchExpression{
+		listExpression(fabricv4.SERVICETOKENSEARCHFIELDNAME_STATE, fabricv4.SERVICETOKENSEARCHEXPRESSIONOPERATOR_IN, states...),
+	}
+	if projectID := filters.ProjectID.ValueString(); projectID != "" {
+		expressions = append(expressions, listExpression(fabricv4.SERVICETOKENSEARCHFIELDNAME_PROJECT_PROJECT_ID, fabricv4.SERVICETOKENSEARCHEXPRESSIONOPERATOR_EQUAL, projectID))
+	}
+	if name := filters.Name.ValueString(); name != "" {
+		expressions = append(expressions, listExpression(fabricv4.SERVICETOKENSEARCHFIELDNAME_NAME, fabricv4.SERVICETOKENSEARCHEXPRESSIONOPERATOR_EQUAL, name))
+	}
+	filter := fabricv4.ServiceTokenSearchExpression{}
+	filter.SetAnd(expressions)
+
+	client := r.Meta.NewFabricClientForList(ctx)
+	items := framework.Paginate(func(offset int32) ([]framework.ListItem, bool, error) {
+		pagination := fabricv4.PaginationRequest{}
+		pagination.SetOffset(offset)
+		pagination.SetLimit(framework.ListPageSize)
+		searchRequest := fabricv4.ServiceTokenSearchRequest{}
+		searchRequest.SetFilter(filter)
+		searchRequest.SetPagination(pagination)
+		serviceTokens, _, err := client.ServiceTokensApi.SearchServiceTokens(ctx).ServiceTokenSearchRequest(searchRequest).Execute()
+		if err != nil {
+			return nil, false, equinix_errors.FormatFabricError(err)
+		}
+		items := make([]framework.ListItem, len(serviceTokens.GetData()))
+		for i, serviceToken := range serviceTokens.GetData() {
+			items[i] = framework.ListItem{ID: serviceToken.GetUuid(), DisplayName: serviceToken.GetName()}
+		}
+		return items, framework.HasMorePages(offset, len(items), serviceTokens.Pagination.GetTotal()), nil
+	})
+	stream.Results = r.Results(ctx, req, items)
+}
+
+func listExpression(property fabricv4.ServiceTokenSearchFieldName, operator fabricv4.ServiceTokenSearchExpressionOperator, values ...string) fabricv4.ServiceTokenSearchExpression {
+	expression := fabricv4.ServiceTokenSearchExpression{}
+	expression.SetProperty(property)
+	expression.SetOperator(operator)
+	expression.SetValues(values)
+	return expression
+}
@@ -255,6 +255,7 @@ func serviceTokenResponseMap(token *fabricv4.ServiceToken) map[string]any {
 	expirationDateTime := token.GetExpirationDateTime()
 	const TimeFormat = "2006-01-02T15:04:05.000Z"
 	serviceToken["expiration_date_time"] = expirationDateTime.Format(TimeFormat)
+	serviceToken["expires_in_days"] = expiresInDays(expirationDateTime, time.Now())
 	if token.Href != nil {
 		serviceToken["href"] = token.GetHref()
 	}
@@ -848,3 +849,25 @@ func paginationGoToTerraform(pagination *fabricv4.Pagination) *schema.Set {
 		[]any{mappedPagination},
 	)
 }
+
+// expiresInDays returns the number of whole days left before the given
+// expiration date and time, negative once it has passed
+func expiresInDays(expiration, now time.Time) int {
+	return int(expiration.Sub(now) / (24 * time.Hour))
+}
+
+// autoExtendExpirationDateTime returns the expiration date and time a service
+// token managed with auto_extend is pushed forward to, and whether it has to be
+// pushed forward at all. Expirations are pushed forward to the start of the day
+// extensionDays after now, so that the planned value does not change between
+// plan and apply.
+func autoExtendExpirationDateTime(expiration string, now time.Time, thresholdDays, extensionDays int) (string, bool) {
+	const TimeFormat = "2006-01-02T15:04:05.000Z"
+	now = now.UTC()
+	if current, err := time.Parse(TimeFormat, expiration); err == nil &&
+		current.After(now.AddDate(0, 0, thresholdDays)) {
+		return expiration, false
+	}
+	extended := now.Truncate(24*time.Hour).AddDate(0, 0, extensionDays)
+	return extended.Format(TimeFormat), true
+}
@@ -0,0 +1,37 @@
+package servicetoken
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceToken_autoExtendExpirationDateTime(t *testing.T) {
+	// given
+	now := time.Date(2026, 3, 10, 15, 30, 0, 0, time.UTC)
+	farExpiration := "2026-04-01T00:00:00.000Z"
+	nearExpiration := "2026-03-12T00:00:00.000Z"
+	// when
+	farValue, farExtended := autoExtendExpirationDateTime(farExpiration, now, 7, 30)
+	nearValue, nearExtended := autoExtendExpirationDateTime(nearExpiration, now, 7, 30)
+	newValue, newExtended := autoExtendExpirationDateTime("", now, 7, 30)
+	// then
+	assert.False(t, farExtended, "Expiration outside of the threshold is kept")
+	assert.Equal(t, farExpiration, farValue, "Expiration outside of the threshold is kept")
+	assert.True(t, nearExtended, "Expiration within the threshold is pushed forward")
+	assert.Equal(t, "2026-04-09T00:00:00.000Z", nearValue, "Expiration is pushed forward to the start of the day")
+	assert.True(t, newExtended, "Missing expiration is set")
+	assert.Equal(t, "2026-04-09T00:00:00.000Z", newValue, "Missing expiration is set from the day of apply")
+}
+
+func TestServiceToken_expiresInDays(t *testing.T) {
+	// given
+	now := time.Date(2026, 3, 10, 15, 30, 0, 0, time.UTC)
+	// when
+	remaining := expiresInDays(now.Add(50*time.Hour), now)
+	expired := expiresInDays(now.Add(-50*time.Hour), now)
+	// then
+	assert.Equal(t, 2, remaining, "Whole days left before expiration are counted")
+	assert.Equal(t, -2, expired, "Expired tokens report negative days")
+}
@@ -4,14 +4,15 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/equinix/equinix-sdk-go/services/fabricv4"
 	"github.com/equinix/terraform-provider-equinix/internal/config"
 	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
 	equinix_fabric_schema "github.com/equinix/terraform-provider-equinix/internal/fabric/schema"
+	"github.com/equinix/terraform-provider-equinix/internal/importer"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -28,26 +29,36 @@ func Resource() *schema.Resource {
 		CreateContext: resourceCreate,
 		UpdateContext: resourceUpdate,
 		DeleteContext: resourceDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Schema:      resourceSchema(),
+		Importer:      importer.UUIDImporter(),
+		Identity:      importer.UUIDIdentity(),
+		Schema:        resourceSchema(),
+		CustomizeDiff: customdiff.All(
+			planAutoExtend,
+			customdiff.ComputedIf("expires_in_days", func(_ context.Context, d *schema.ResourceDiff, _ any) bool {
+				return d.HasChange("expiration_date_time")
+			}),
+		),
 		Description: `Fabric V4 API compatible resource allows creation and management of Equinix Fabric Service Token`,
 	}
 }
 
 func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
-	serviceToken, _, err := client.ServiceTokensApi.GetServiceTokenByUuid(ctx, d.Id()).Execute()
+	serviceToken, httpResp, err := client.ServiceTokensApi.GetServiceTokenByUuid(ctx, d.Id()).Execute()
+	if err == nil {
+		err = equinix_errors.CheckFabricDeletedState(d.Id(), string(serviceToken.GetState()))
+	}
 	if err != nil {
-		log.Printf("[WARN] Service Token %s not found , error %s", d.Id(), err)
-		if !strings.Contains(err.Error(), "500") {
-			d.SetId("")
-		}
-		return diag.FromErr(equinix_errors.FormatFabricError(err))
+		return equinix_errors.FabricReadDiagnostics(d, httpResp, err)
 	}
 	d.SetId(serviceToken.GetUuid())
-	return setServiceTokenMap(d, serviceToken)
+	if diags := setServiceTokenMap(d, serviceToken); diags.HasError() {
+		return diags
+	}
+	if err := importer.SetUUIDIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
 func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -102,10 +113,37 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		}
 
 	}
+	if d.HasChange("resend_notification_triggers") && len(d.Get("resend_notification_triggers").(map[string]any)) > 0 {
+		actionRequest := fabricv4.ServiceTokenActionRequest{Type: fabricv4.SERVICETOKENACTIONS_RESEND_EMAIL_NOTIFICATION}
+		_, _, err = client.ServiceTokensApi.CreateServiceTokenAction(ctx, d.Id()).ServiceTokenActionRequest(actionRequest).Execute()
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{Severity: 0, Summary: fmt.Sprintf("service token resend email notification request error: %v", equinix_errors.FormatFabricError(err))})
+		}
+	}
 	d.SetId(dbToken.GetUuid())
 	return append(diags, setServiceTokenMap(d, dbToken)...)
 }
 
+// planAutoExtend plans pushing the expiration of service tokens managed with
+// auto_extend forward when they are within the configured threshold of expiring
+func planAutoExtend(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	autoExtend := d.Get("auto_extend").([]any)
+	if len(autoExtend) == 0 || autoExtend[0] == nil {
+		return nil
+	}
+	autoExtendMap := autoExtend[0].(map[string]any)
+	expiration, extend := autoExtendExpirationDateTime(
+		d.Get("expiration_date_time").(string),
+		time.Now(),
+		autoExtendMap["threshold_days"].(int),
+		autoExtendMap["extension_days"].(int),
+	)
+	if !extend {
+		return nil
+	}
+	return d.SetNew("expiration_date_time", expiration)
+}
+
 func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	diags := diag.Diagnostics{}
 	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
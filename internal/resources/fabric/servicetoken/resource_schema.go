@@ -42,9 +42,46 @@ func resourceSchema() map[string]*schema.Schema {
 			Description: "Optional Description to the Service Token you will be creating",
 		},
 		"expiration_date_time": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Description: "Expiration date and time of the service token; 2020-11-06T07:00:00Z",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{"expiration_date_time", "auto_extend"},
+			Description:  "Expiration date and time of the service token; 2020-11-06T07:00:00Z. Conflicts with auto_extend",
+		},
+		"auto_extend": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Manages the expiration date and time of the service token, pushing it forward on apply when the token is about to expire. Conflicts with expiration_date_time",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"threshold_days": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      7,
+						ValidateFunc: validation.IntAtLeast(0),
+						Description:  "Number of days before expiration within which the expiration is pushed forward. Defaults to 7",
+					},
+					"extension_days": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      30,
+						ValidateFunc: validation.IntAtLeast(1),
+						Description:  "Number of days from the day of apply the expiration is pushed forward to. Defaults to 30",
+					},
+				},
+			},
+		},
+		"expires_in_days": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of whole days left before the service token expires, negative once it has expired",
+		},
+		"resend_notification_triggers": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Arbitrary map of values that, when changed, will email the service token to the notification recipients again",
 		},
 		"service_token_connection": {
 			Type:        schema.TypeSet,
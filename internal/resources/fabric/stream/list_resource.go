@@ -0,0 +1,141 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listStates are the stream states listed when no states are given,
+// which leave out deprovisioned streams
+var listStates = []string{
+	string(fabricv4.STREAMSTATE_PROVISIONING),
+	string(fabricv4.STREAMSTATE_PROVISIONED),
+	string(fabricv4.STREAMSTATE_REPROVISIONING),
+	string(fabricv4.STREAMSTATE_DEPROVISIONING),
+	string(fabricv4.STREAMSTATE_FAILED),
+}
+
+// NewListResource creates a list resource for Fabric streams
+func NewListResource() list.ListResource {
+	return &ListResource{
+		BaseListResource: framework.NewBaseListResource(
+			framework.BaseListResourceConfig{
+				Name: "equinix_fabric_stream",
+			},
+		),
+	}
+}
+
+// ListResource lists Fabric streams matching the given filters
+type ListResource struct {
+	framework.BaseListResource
+}
+
+type listResourceModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	Name      types.String `tfsdk:"name"`
+	States    types.List   `tfsdk:"states"`
+}
+
+// ListResourceConfigSchema returns the schema of the list block filters
+func (r *ListResource) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	stateValues := make([]string, len(fabricv4.AllowedStreamStateEnumValues))
+	for i, state := range fabricv4.AllowedStreamStateEnumValues {
+		stateValues[i] = string(state)
+	}
+	resp.Schema = schema.Schema{
+		Description: "Lists the Fabric streams matching the given filters",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "Project ID the streams belong to",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the streams",
+				Optional:    true,
+			},
+			"states": schema.ListAttribute{
+				Description: fmt.Sprintf("States of the streams. Defaults to all states except DEPROVISIONED. One of %v", stateValues),
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(stateValues...)),
+				},
+			},
+		},
+	}
+}
+
+// List retrieves the streams one page at a time and keeps those matching the
+// filters, as streams cannot be searched
+func (r *ListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var filters listResourceModel
+	diags := req.Config.Get(ctx, &filters)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	states := listStates
+	if !filters.States.IsNull() {
+		states = nil
+		diags.Append(filters.States.ElementsAs(ctx, &states, false)...)
+		if diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+	projectID := filters.ProjectID.ValueString()
+	name := filters.Name.ValueString()
+
+	client := r.Meta.NewFabricClientForList(ctx)
+	streams := framework.Paginate(func(offset int32) ([]fabricv4.Stream, bool, error) {
+		streams, _, err := client.StreamsApi.GetStreams(ctx).Offset(offset).Limit(framework.ListPageSize).Execute()
+		if err != nil {
+			return nil, false, equinix_errors.FormatFabricError(err)
+		}
+		return streams.GetData(), framework.HasMorePages(offset, len(streams.GetData()), streams.Pagination.GetTotal()), nil
+	})
+	stream.Results = framework.ListResults(ctx, req, streams, func(ctx context.Context, s fabricv4.Stream) (list.ListResult, bool) {
+		if !slices.Contains(states, string(s.GetState())) ||
+			(projectID != "" && s.Project.GetProjectId() != projectID) ||
+			(name != "" && s.GetName() != name) {
+			return list.ListResult{}, false
+		}
+
+		result := framework.NewListResult(ctx, req, framework.ListItem{ID: s.GetUuid(), DisplayName: s.GetName()})
+		if !req.IncludeResource || result.Diagnostics.HasError() {
+			return result, true
+		}
+
+		var model ResourceModel
+		result.Diagnostics.Append(result.Resource.GetAttribute(ctx, path.Root("timeouts"), &model.Timeouts)...)
+		result.Diagnostics.Append(model.parse(ctx, &s)...)
+		if result.Diagnostics.HasError() {
+			return result, true
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+		return result, true
+	})
+}
@@ -98,6 +98,7 @@ func (r *Resource) Create(
 
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *Resource) Read(
@@ -118,8 +119,16 @@ func (r *Resource) Read(
 	// Extract the ID of the resource from the state
 	id := state.ID.ValueString()
 
-	stream, _, err := client.StreamsApi.GetStreamByUuid(ctx, id).Execute()
+	stream, httpResp, err := client.StreamsApi.GetStreamByUuid(ctx, id).Execute()
+	if err == nil {
+		err = equinix_errors.CheckFabricDeletedState(id, string(stream.GetState()))
+	}
 	if err != nil {
+		if equinix_errors.IsFabricNotFound(httpResp, err) {
+			resp.Diagnostics.Append(equinix_errors.NewResourceNotFoundWarningDiagnostic(err))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed retrieving Stream %s", id), equinix_errors.FormatFabricError(err).Error())
 		return
@@ -133,6 +142,7 @@ func (r *Resource) Read(
 
 	// Update the Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 }
 
 // Update modifies an existing stream
@@ -196,6 +206,7 @@ func (r *Resource) Update(
 
 	// Set the updated state back into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 }
 
 // Delete removes the stream
@@ -2,6 +2,8 @@ package streamattachment
 
 import (
 	"context"
+	"slices"
+	"strings"
 
 	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
 
@@ -57,6 +59,26 @@ type ResourceModel struct {
 	BaseAssetModel
 }
 
+type BulkResourceModel struct {
+	ID             types.String                                `tfsdk:"id"`
+	Timeouts       timeouts.Value                              `tfsdk:"timeouts"`
+	StreamID       types.String                                `tfsdk:"stream_id"`
+	Selector       fwtypes.ObjectValueOf[SelectorModel]        `tfsdk:"selector"`
+	MetricsEnabled types.Bool                                  `tfsdk:"metrics_enabled"`
+	Assets         fwtypes.ListNestedObjectValueOf[AssetModel] `tfsdk:"assets"`
+}
+
+type SelectorModel struct {
+	ProjectID   types.String `tfsdk:"project_id"`
+	AssetType   types.String `tfsdk:"asset_type"`
+	NamePattern types.String `tfsdk:"name_pattern"`
+}
+
+type AssetModel struct {
+	Asset   types.String `tfsdk:"asset"`
+	AssetID types.String `tfsdk:"asset_id"`
+}
+
 type BaseAssetModel struct {
 	MetricsEnabled   types.Bool   `tfsdk:"metrics_enabled"`
 	Type             types.String `tfsdk:"type"`
@@ -127,3 +149,70 @@ func (m *BaseAssetModel) parse(_ context.Context, stream *fabricv4.StreamAsset)
 
 	return diag
 }
+
+// streamAsset identifies an asset attached to a stream by the bulk resource
+type streamAsset struct {
+	asset   string
+	assetID string
+}
+
+func (m *BulkResourceModel) parse(ctx context.Context, assets []streamAsset) {
+	m.ID = m.StreamID
+	m.Assets = assetModels(ctx, assets)
+}
+
+func assetModels(ctx context.Context, assets []streamAsset) fwtypes.ListNestedObjectValueOf[AssetModel] {
+	sortStreamAssets(assets)
+	models := make([]AssetModel, len(assets))
+	for i, asset := range assets {
+		models[i] = AssetModel{
+			Asset:   types.StringValue(asset.asset),
+			AssetID: types.StringValue(asset.assetID),
+		}
+	}
+	return fwtypes.NewListNestedObjectValueOfValueSlice(ctx, models)
+}
+
+func (m *BulkResourceModel) streamAssets(ctx context.Context) ([]streamAsset, diag.Diagnostics) {
+	if m.Assets.IsNull() || m.Assets.IsUnknown() {
+		return nil, nil
+	}
+	models := make([]AssetModel, len(m.Assets.Elements()))
+	diags := m.Assets.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	assets := make([]streamAsset, len(models))
+	for i, model := range models {
+		assets[i] = streamAsset{asset: model.Asset.ValueString(), assetID: model.AssetID.ValueString()}
+	}
+	return assets, diags
+}
+
+// sortStreamAssets orders the assets by category and ID so that the planned
+// assets do not change when the search APIs return them in another order
+func sortStreamAssets(assets []streamAsset) {
+	slices.SortFunc(assets, func(a, b streamAsset) int {
+		if c := strings.Compare(a.asset, b.asset); c != 0 {
+			return c
+		}
+		return strings.Compare(a.assetID, b.assetID)
+	})
+}
+
+// diffStreamAssets returns the desired assets that still have to be attached
+// and the current assets that are no longer selected and have to be detached
+func diffStreamAssets(current, desired []streamAsset) (attach, detach []streamAsset) {
+	return withoutStreamAssets(desired, current), withoutStreamAssets(current, desired)
+}
+
+// withoutStreamAssets returns the assets that are not part of removed
+func withoutStreamAssets(assets, removed []streamAsset) []streamAsset {
+	var remaining []streamAsset
+	for _, asset := range assets {
+		if !slices.Contains(removed, asset) {
+			remaining = append(remaining, asset)
+		}
+	}
+	return remaining
+}
@@ -0,0 +1,107 @@
+package streamattachment
+
+import (
+	"context"
+	"testing"
+
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamAttachments_diffStreamAssets(t *testing.T) {
+	// given
+	port := streamAsset{asset: "ports", assetID: "port-uuid"}
+	connection := streamAsset{asset: "connections", assetID: "connection-uuid"}
+	router := streamAsset{asset: "routers", assetID: "router-uuid"}
+	current := []streamAsset{port, connection}
+	desired := []streamAsset{connection, router}
+	// when
+	attach, detach := diffStreamAssets(current, desired)
+	// then
+	assert.Equal(t, []streamAsset{router}, attach, "Newly selected assets are attached")
+	assert.Equal(t, []streamAsset{port}, detach, "Assets no longer selected are detached")
+}
+
+func TestStreamAttachments_sortStreamAssets(t *testing.T) {
+	// given
+	assets := []streamAsset{
+		{asset: "routers", assetID: "b"},
+		{asset: "connections", assetID: "z"},
+		{asset: "routers", assetID: "a"},
+	}
+	// when
+	sortStreamAssets(assets)
+	// then
+	assert.Equal(t, []streamAsset{
+		{asset: "connections", assetID: "z"},
+		{asset: "routers", assetID: "a"},
+		{asset: "routers", assetID: "b"},
+	}, assets, "Assets are ordered by category and ID")
+}
+
+func testBulkResourceModel(ctx context.Context, namePattern string, assets fwtypes.ListNestedObjectValueOf[AssetModel]) BulkResourceModel {
+	timeoutsType := bulkResourceSchema(ctx).Attributes["timeouts"].GetType().(timeouts.Type)
+	return BulkResourceModel{
+		ID:       types.StringValue("stream-uuid"),
+		Timeouts: timeouts.Value{Object: types.ObjectNull(timeoutsType.AttributeTypes())},
+		StreamID: types.StringValue("stream-uuid"),
+		Selector: fwtypes.NewObjectValueOf(ctx, &SelectorModel{
+			ProjectID:   types.StringValue("project-uuid"),
+			AssetType:   types.StringValue("routers"),
+			NamePattern: types.StringValue(namePattern),
+		}),
+		MetricsEnabled: types.BoolValue(false),
+		Assets:         assets,
+	}
+}
+
+func testBulkResourceModifyPlan(t *testing.T, state *BulkResourceModel, plan BulkResourceModel) BulkResourceModel {
+	ctx := context.Background()
+	s := bulkResourceSchema(ctx)
+	null := tftypes.NewValue(s.Type().TerraformType(ctx), nil)
+	req := resource.ModifyPlanRequest{
+		State: tfsdk.State{Schema: s, Raw: null},
+		Plan:  tfsdk.Plan{Schema: s, Raw: null},
+	}
+	if state != nil {
+		assert.False(t, req.State.Set(ctx, state).HasError())
+	}
+	assert.False(t, req.Plan.Set(ctx, &plan).HasError())
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+	r := &BulkResource{}
+	r.ModifyPlan(ctx, req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "ModifyPlan does not return an error")
+	var modified BulkResourceModel
+	assert.False(t, resp.Plan.Get(ctx, &modified).HasError())
+	return modified
+}
+
+func TestStreamAttachments_modifyPlanOnCreate(t *testing.T) {
+	// given
+	ctx := context.Background()
+	plan := testBulkResourceModel(ctx, "^router$", fwtypes.NewListNestedObjectValueOfUnknown[AssetModel](ctx))
+	// when
+	modified := testBulkResourceModifyPlan(t, nil, plan)
+	// then
+	assert.True(t, modified.Assets.IsUnknown(), "Assets are searched for on apply when the resource is created")
+}
+
+func TestStreamAttachments_modifyPlanOnSelectorChange(t *testing.T) {
+	// given
+	ctx := context.Background()
+	attached := assetModels(ctx, []streamAsset{{asset: "routers", assetID: "router-uuid"}})
+	state := testBulkResourceModel(ctx, "^router$", attached)
+	plan := testBulkResourceModel(ctx, "^other_router$", attached)
+	// when
+	modified := testBulkResourceModifyPlan(t, &state, plan)
+	// then
+	assert.True(t, modified.Assets.IsUnknown(), "Assets are searched for on apply when the selector changes")
+}
@@ -20,7 +20,9 @@ func NewResource() resource.Resource {
 	return &Resource{
 		BaseResource: framework.NewBaseResource(
 			framework.BaseResourceConfig{
-				Name: "equinix_fabric_stream_attachment",
+				Name:          "equinix_fabric_stream_attachment",
+				IDAttr:        "asset_id",
+				ParentIDAttrs: []string{"stream_id", "asset"},
 			},
 		),
 	}
@@ -85,6 +87,7 @@ func (r *Resource) Create(
 
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *Resource) Read(
@@ -104,8 +107,13 @@ func (r *Resource) Read(
 
 	assetID, asset, streamID := state.AssetID.ValueString(), state.Asset.ValueString(), state.StreamID.ValueString()
 
-	attachment, _, err := client.StreamsApi.GetStreamAssetByUuid(ctx, assetID, fabricv4.Asset(asset), streamID).Execute()
+	attachment, httpResp, err := client.StreamsApi.GetStreamAssetByUuid(ctx, assetID, fabricv4.Asset(asset), streamID).Execute()
 	if err != nil {
+		if equinix_errors.IsFabricNotFound(httpResp, err) {
+			resp.Diagnostics.Append(equinix_errors.NewResourceNotFoundWarningDiagnostic(err))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("failed retrieving stream attachment %s", attachment.GetUuid()), equinix_errors.FormatFabricError(err).Error())
 		return
@@ -119,6 +127,7 @@ func (r *Resource) Read(
 
 	// Update the Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *Resource) Update(
@@ -175,6 +184,7 @@ func (r *Resource) Update(
 
 	// Set the updated state back into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *Resource) Delete(
@@ -197,8 +207,7 @@ func (r *Resource) Delete(
 	_, deleteResp, err := client.StreamsApi.DeleteStreamAssetByUuid(ctx, assetID, fabricv4.Asset(asset), streamID).Execute()
 	if err != nil {
 
-		//Design decision from API team was to return 400 for all errors instead of 404 for not found
-		if deleteResp == nil || !slices.Contains([]int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound}, deleteResp.StatusCode) {
+		if !isStreamAssetNotFound(deleteResp) {
 			resp.Diagnostics.AddError(
 				fmt.Sprintf("failed deleting stream attachment %s", id), equinix_errors.FormatFabricError(err).Error())
 			return
@@ -259,8 +268,7 @@ func getDeleteWaiter(ctx context.Context, client *fabricv4.APIClient, assetID, a
 		Refresh: func() (any, string, error) {
 			stream, resp, err := client.StreamsApi.GetStreamAssetByUuid(ctx, assetID, fabricv4.Asset(asset), streamID).Execute()
 			if err != nil {
-				//Design decision from API team was to return 400 for all errors instead of 404 for not found
-				if resp != nil && slices.Contains([]int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound}, resp.StatusCode) {
+				if isStreamAssetNotFound(resp) {
 					return stream, deletedMarker, nil
 				}
 				return 0, "", err
@@ -272,3 +280,10 @@ func getDeleteWaiter(ctx context.Context, client *fabricv4.APIClient, assetID, a
 		MinTimeout: 5 * time.Second,
 	}
 }
+
+// isStreamAssetNotFound reports whether the response indicates that the asset
+// is not attached to the stream
+func isStreamAssetNotFound(resp *http.Response) bool {
+	//Design decision from API team was to return 400 for all errors instead of 404 for not found
+	return resp != nil && slices.Contains([]int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound}, resp.StatusCode)
+}
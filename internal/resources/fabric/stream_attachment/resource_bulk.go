@@ -0,0 +1,516 @@
+package streamattachment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"regexp"
+	"time"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// bulkAssetCategories are the asset categories the bulk resource can search for
+var bulkAssetCategories = []string{
+	string(fabricv4.ASSET_PORTS),
+	string(fabricv4.ASSET_CONNECTIONS),
+	string(fabricv4.ASSET_ROUTERS),
+}
+
+func NewBulkResource() resource.Resource {
+	return &BulkResource{
+		BaseResource: framework.NewBaseResource(
+			framework.BaseResourceConfig{
+				Name:   "equinix_fabric_stream_attachments",
+				IDAttr: "stream_id",
+			},
+		),
+	}
+}
+
+type BulkResource struct {
+	framework.BaseResource
+}
+
+var _ resource.ResourceWithModifyPlan = &BulkResource{}
+
+func (r *BulkResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = bulkResourceSchema(ctx)
+}
+
+// ModifyPlan searches for the assets matching the selector so that assets
+// added or removed since the last apply show up in the plan. Assets are left
+// unknown, and searched for on apply, when the resource is created or its
+// selector changes, for assets created in the same apply to be attached
+func (r *BulkResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to search for when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan BulkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		plan.Assets = fwtypes.NewListNestedObjectValueOfUnknown[AssetModel](ctx)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	var state BulkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	selector, diags := plan.selector(ctx)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	if selector == nil || !plan.Selector.Equal(state.Selector) {
+		plan.Assets = fwtypes.NewListNestedObjectValueOfUnknown[AssetModel](ctx)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+	assets, err := searchStreamAssets(ctx, client, *selector)
+	if err != nil {
+		resp.Diagnostics.AddError("failed searching for assets to attach to the stream", err.Error())
+		return
+	}
+	plan.Assets = assetModels(ctx, assets)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *BulkResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan BulkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve the API client from the provider metadata
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	assets, diags := plan.desiredAssets(ctx, client)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	streamID := plan.StreamID.ValueString()
+	attached, err := attachStreamAssets(ctx, client, streamID, assets, plan.MetricsEnabled.ValueBool(), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed creating stream attachments for stream %s", streamID), err.Error())
+	}
+
+	// The assets attached before a failure are kept in state so that they
+	// are detached when the tainted resource is replaced
+	plan.parse(ctx, attached)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *BulkResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state BulkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve the API client from the provider metadata
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	assets, diags := state.streamAssets(ctx)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	streamID := state.StreamID.ValueString()
+	attached := make([]streamAsset, 0, len(assets))
+	for _, asset := range assets {
+		attachment, httpResp, err := client.StreamsApi.GetStreamAssetByUuid(ctx, asset.assetID, fabricv4.Asset(asset.asset), streamID).Execute()
+		if err != nil {
+			if isStreamAssetNotFound(httpResp) {
+				continue
+			}
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("failed retrieving stream attachment of %s %s", asset.asset, asset.assetID), equinix_errors.FormatFabricError(err).Error())
+			return
+		}
+		// Attachments that are detached or whose metrics setting drifted are
+		// left out so that they are attached again on the next apply
+		if attachment.GetAttachmentStatus() != fabricv4.STREAMASSETATTACHMENTSTATUS_ATTACHED ||
+			attachment.GetMetricsEnabled() != state.MetricsEnabled.ValueBool() {
+			continue
+		}
+		attached = append(attached, asset)
+	}
+
+	state.parse(ctx, attached)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *BulkResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	// Retrieve values from plan
+	var state, plan BulkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := state.streamAssets(ctx)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	desired, diags := plan.desiredAssets(ctx, client)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	attach, detach := diffStreamAssets(current, desired)
+	// Changing metrics_enabled has to be applied to every attachment
+	if plan.MetricsEnabled.ValueBool() != state.MetricsEnabled.ValueBool() {
+		attach = desired
+	}
+
+	streamID := plan.StreamID.ValueString()
+	detached, err := detachStreamAssets(ctx, client, streamID, detach, updateTimeout)
+	remaining := withoutStreamAssets(current, detached)
+	if err == nil {
+		var attached []streamAsset
+		attached, err = attachStreamAssets(ctx, client, streamID, attach, plan.MetricsEnabled.ValueBool(), updateTimeout)
+		remaining = append(remaining, withoutStreamAssets(attached, remaining)...)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed updating stream attachments for stream %s", streamID), err.Error())
+	}
+
+	plan.parse(ctx, remaining)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *BulkResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	// Retrieve the API client
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	// Retrieve the current state
+	var state BulkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assets, diags := state.streamAssets(ctx)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	streamID := state.StreamID.ValueString()
+	if _, err := detachStreamAssets(ctx, client, streamID, assets, deleteTimeout); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed deleting stream attachments for stream %s", streamID), err.Error())
+	}
+}
+
+// selector returns the selector of the plan, or nil while any of its
+// attributes is unknown
+func (m *BulkResourceModel) selector(ctx context.Context) (*SelectorModel, diag.Diagnostics) {
+	if m.Selector.IsUnknown() {
+		return nil, nil
+	}
+	var selector SelectorModel
+	diags := m.Selector.As(ctx, &selector, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+	if selector.ProjectID.IsUnknown() || selector.AssetType.IsUnknown() || selector.NamePattern.IsUnknown() {
+		return nil, diags
+	}
+	return &selector, diags
+}
+
+// desiredAssets returns the planned assets, searching for them when they
+// were left unknown at plan time
+func (m *BulkResourceModel) desiredAssets(ctx context.Context, client *fabricv4.APIClient) ([]streamAsset, diag.Diagnostics) {
+	if !m.Assets.IsUnknown() {
+		return m.streamAssets(ctx)
+	}
+	selector, diags := m.selector(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if selector == nil {
+		diags.AddError("unknown stream attachments selector", "the selector is still unknown at apply time")
+		return nil, diags
+	}
+	assets, err := searchStreamAssets(ctx, client, *selector)
+	if err != nil {
+		diags.AddError("failed searching for assets to attach to the stream", err.Error())
+	}
+	return assets, diags
+}
+
+// attachStreamAssets attaches the assets to the stream and waits for the
+// attachments to complete. It returns the assets that were attached, which
+// are only part of the assets when an error is returned
+func attachStreamAssets(ctx context.Context, client *fabricv4.APIClient, streamID string, assets []streamAsset, metricsEnabled bool, timeout time.Duration) ([]streamAsset, error) {
+	var errs []error
+	requested := make([]streamAsset, 0, len(assets))
+	for _, asset := range assets {
+		putRequest := fabricv4.StreamAssetPutRequest{}
+		putRequest.SetMetricsEnabled(metricsEnabled)
+		_, _, err := client.StreamsApi.UpdateStreamAssetByUuid(ctx, asset.assetID, fabricv4.Asset(asset.asset), streamID).StreamAssetPutRequest(putRequest).Execute()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed attaching %s %s: %w", asset.asset, asset.assetID, equinix_errors.FormatFabricError(err)))
+			break
+		}
+		requested = append(requested, asset)
+	}
+
+	deadline := time.Now().Add(timeout)
+	attached := make([]streamAsset, 0, len(requested))
+	for _, asset := range requested {
+		createWaiter := getCreateUpdateWaiter(ctx, client, asset.assetID, asset.asset, streamID, time.Until(deadline))
+		if _, err := createWaiter.WaitForStateContext(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed waiting for %s %s to be attached: %w", asset.asset, asset.assetID, err))
+			continue
+		}
+		attached = append(attached, asset)
+	}
+	return attached, errors.Join(errs...)
+}
+
+// detachStreamAssets detaches the assets from the stream and waits for the
+// detachments to complete. It returns the assets that were detached, which
+// are only part of the assets when an error is returned
+func detachStreamAssets(ctx context.Context, client *fabricv4.APIClient, streamID string, assets []streamAsset, timeout time.Duration) ([]streamAsset, error) {
+	var errs []error
+	requested := make([]streamAsset, 0, len(assets))
+	for _, asset := range assets {
+		_, deleteResp, err := client.StreamsApi.DeleteStreamAssetByUuid(ctx, asset.assetID, fabricv4.Asset(asset.asset), streamID).Execute()
+		if err != nil && !isStreamAssetNotFound(deleteResp) {
+			errs = append(errs, fmt.Errorf("failed detaching %s %s: %w", asset.asset, asset.assetID, equinix_errors.FormatFabricError(err)))
+			break
+		}
+		requested = append(requested, asset)
+	}
+
+	deadline := time.Now().Add(timeout)
+	detached := make([]streamAsset, 0, len(requested))
+	for _, asset := range requested {
+		deleteWaiter := getDeleteWaiter(ctx, client, asset.assetID, asset.asset, streamID, time.Until(deadline))
+		if _, err := deleteWaiter.WaitForStateContext(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed waiting for %s %s to be detached: %w", asset.asset, asset.assetID, err))
+			continue
+		}
+		detached = append(detached, asset)
+	}
+	return detached, errors.Join(errs...)
+}
+
+// searchStreamAssets returns the assets of the selected categories that
+// belong to the project and whose name matches the name pattern
+func searchStreamAssets(ctx context.Context, client *fabricv4.APIClient, selector SelectorModel) ([]streamAsset, error) {
+	var namePattern *regexp.Regexp
+	if pattern := selector.NamePattern.ValueString(); pattern != "" {
+		var err error
+		namePattern, err = regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector name_pattern %q: %w", pattern, err)
+		}
+	}
+	categories := bulkAssetCategories
+	if assetType := selector.AssetType.ValueString(); assetType != "" {
+		categories = []string{assetType}
+	}
+
+	projectID := selector.ProjectID.ValueString()
+	var assets []streamAsset
+	for _, category := range categories {
+		var items iter.Seq2[framework.ListItem, error]
+		switch fabricv4.Asset(category) {
+		case fabricv4.ASSET_PORTS:
+			items = searchPorts(ctx, client, projectID)
+		case fabricv4.ASSET_CONNECTIONS:
+			items = searchConnections(ctx, client, projectID)
+		case fabricv4.ASSET_ROUTERS:
+			items = searchCloudRouters(ctx, client, projectID)
+		default:
+			return nil, fmt.Errorf("assets of category %s cannot be searched for", category)
+		}
+		for item, err := range items {
+			if err != nil {
+				return nil, fmt.Errorf("failed searching for %s: %w", category, err)
+			}
+			if namePattern == nil || namePattern.MatchString(item.DisplayName) {
+				assets = append(assets, streamAsset{asset: category, assetID: item.ID})
+			}
+		}
+	}
+	return assets, nil
+}
+
+func searchPorts(ctx context.Context, client *fabricv4.APIClient, projectID string) iter.Seq2[framework.ListItem, error] {
+	filter := fabricv4.PortExpression{}
+	filter.SetAnd([]fabricv4.PortExpression{
+		portExpression(fabricv4.PORTSEARCHFIELDNAME_PROJECT_PROJECT_ID, projectID),
+		portExpression(fabricv4.PORTSEARCHFIELDNAME_STATE, string(fabricv4.PORTSTATE_ACTIVE)),
+	})
+	return framework.Paginate(func(offset int32) ([]framework.ListItem, bool, error) {
+		pagination := fabricv4.PaginationRequest{}
+		pagination.SetOffset(offset)
+		pagination.SetLimit(framework.ListPageSize)
+		searchRequest := fabricv4.PortV4SearchRequest{}
+		searchRequest.SetFilter(filter)
+		searchRequest.SetPagination(pagination)
+		ports, _, err := client.PortsApi.SearchPorts(ctx).PortV4SearchRequest(searchRequest).Execute()
+		if err != nil {
+			return nil, false, equinix_errors.FormatFabricError(err)
+		}
+		items := make([]framework.ListItem, len(ports.GetData()))
+		for i, port := range ports.GetData() {
+			items[i] = framework.ListItem{ID: port.GetUuid(), DisplayName: port.GetName()}
+		}
+		return items, framework.HasMorePages(offset, len(items), ports.Pagination.GetTotal()), nil
+	})
+}
+
+func searchConnections(ctx context.Context, client *fabricv4.APIClient, projectID string) iter.Seq2[framework.ListItem, error] {
+	filter := fabricv4.Expression{}
+	filter.SetAnd([]fabricv4.Expression{
+		connectionExpression(fabricv4.SEARCHFIELDNAME_PROJECT_PROJECT_ID, fabricv4.EXPRESSIONOPERATOR_EQUAL, projectID),
+		connectionExpression(fabricv4.SEARCHFIELDNAME_STATE, fabricv4.EXPRESSIONOPERATOR_IN,
+			string(fabricv4.CONNECTIONSTATE_ACTIVE), string(fabricv4.CONNECTIONSTATE_PROVISIONED)),
+	})
+	return framework.Paginate(func(offset int32) ([]framework.ListItem, bool, error) {
+		pagination := fabricv4.PaginationRequest{}
+		pagination.SetOffset(offset)
+		pagination.SetLimit(framework.ListPageSize)
+		searchRequest := fabricv4.SearchRequest{}
+		searchRequest.SetFilter(filter)
+		searchRequest.SetPagination(pagination)
+		connections, _, err := client.ConnectionsApi.SearchConnections(ctx).SearchRequest(searchRequest).Execute()
+		if err != nil {
+			return nil, false, equinix_errors.FormatFabricError(err)
+		}
+		items := make([]framework.ListItem, len(connections.GetData()))
+		for i, connection := range connections.GetData() {
+			items[i] = framework.ListItem{ID: connection.GetUuid(), DisplayName: connection.GetName()}
+		}
+		return items, framework.HasMorePages(offset, len(items), connections.Pagination.GetTotal()), nil
+	})
+}
+
+func searchCloudRouters(ctx context.Context, client *fabricv4.APIClient, projectID string) iter.Seq2[framework.ListItem, error] {
+	filter := fabricv4.CloudRouterFilters{}
+	filter.SetAnd([]fabricv4.CloudRouterFilter{
+		cloudRouterFilter("/project/projectId", projectID),
+		cloudRouterFilter("/state", string(fabricv4.CLOUDROUTERACCESSPOINTSTATE_PROVISIONED)),
+	})
+	return framework.Paginate(func(offset int32) ([]framework.ListItem, bool, error) {
+		pagination := fabricv4.PaginationRequest{}
+		pagination.SetOffset(offset)
+		pagination.SetLimit(framework.ListPageSize)
+		searchRequest := fabricv4.CloudRouterSearchRequest{}
+		searchRequest.SetFilter(filter)
+		searchRequest.SetPagination(pagination)
+		cloudRouters, _, err := client.CloudRoutersApi.SearchCloudRouters(ctx).CloudRouterSearchRequest(searchRequest).Execute()
+		if err != nil {
+			return nil, false, equinix_errors.FormatFabricError(err)
+		}
+		items := make([]framework.ListItem, len(cloudRouters.GetData()))
+		for i, cloudRouter := range cloudRouters.GetData() {
+			items[i] = framework.ListItem{ID: cloudRouter.GetUuid(), DisplayName: cloudRouter.GetName()}
+		}
+		return items, framework.HasMorePages(offset, len(items), cloudRouters.Pagination.GetTotal()), nil
+	})
+}
+
+func portExpression(property fabricv4.PortSearchFieldName, value string) fabricv4.PortExpression {
+	expression := fabricv4.PortExpression{}
+	expression.SetProperty(property)
+	expression.SetOperator(fabricv4.IPBLOCKANDQUERYOPERATOR_EQUAL)
+	expression.SetValues([]string{value})
+	return expression
+}
+
+func connectionExpression(property fabricv4.SearchFieldName, operator fabricv4.ExpressionOperator, values ...string) fabricv4.Expression {
+	expression := fabricv4.Expression{}
+	expression.SetProperty(property)
+	expression.SetOperator(operator)
+	expression.SetValues(values)
+	return expression
+}
+
+func cloudRouterFilter(property, value string) fabricv4.CloudRouterFilter {
+	expression := fabricv4.CloudRouterSimpleExpression{}
+	expression.SetProperty(property)
+	expression.SetOperator(string(fabricv4.EXPRESSIONOPERATOR_EQUAL))
+	expression.SetValues([]string{value})
+	return fabricv4.CloudRouterFilter{CloudRouterSimpleExpression: &expression}
+}
@@ -0,0 +1,92 @@
+package streamattachment
+
+import (
+	"context"
+
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func bulkResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: `Fabric V4 API compatible resource allows attaching every Equinix Fabric asset matching a selector to a Stream
+
+The assets matching the selector are searched for on every plan, newly matching assets are attached and assets that no longer match are detached on apply. When the resource is created or its selector changes, the assets are searched for on apply instead, so that assets created in the same apply are attached. Ports, connections and cloud routers can be selected.
+
+Additional Documentation:
+* Getting Started: https://docs.equinix.com/observability/streaming-data/integratewithsink/
+* API: https://docs.equinix.com/api-catalog/fabricv4/#tag/Streams`,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttributeDefaultDescription(),
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+			"stream_id": schema.StringAttribute{
+				Description: "UUID of the stream that is the target of the asset attachments",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"selector": schema.SingleNestedAttribute{
+				Description: "Criteria the assets need to match to be attached to the stream",
+				Required:    true,
+				CustomType:  fwtypes.NewObjectTypeOf[SelectorModel](ctx),
+				Attributes: map[string]schema.Attribute{
+					"project_id": schema.StringAttribute{
+						Description: "Project the assets belong to",
+						Required:    true,
+					},
+					"asset_type": schema.StringAttribute{
+						Description: "Equinix defined asset category to select. One of ports, connections or routers. All of them are selected when not set",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(bulkAssetCategories...),
+						},
+					},
+					"name_pattern": schema.StringAttribute{
+						Description: "Regular expression the asset names need to match",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+			"metrics_enabled": schema.BoolAttribute{
+				Description: "Boolean value indicating enablement of metrics for the asset stream attachments",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"assets": schema.ListNestedAttribute{
+				Description: "Assets attached to the stream",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[AssetModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"asset": schema.StringAttribute{
+							Description: "Equinix defined asset category",
+							Computed:    true,
+						},
+						"asset_id": schema.StringAttribute{
+							Description: "Equinix defined UUID of the asset attached to the stream",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,111 @@
+package streamattachment_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/equinix/terraform-provider-equinix/internal/acceptance"
+	"github.com/equinix/terraform-provider-equinix/internal/config"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func CheckStreamAttachmentsDelete(s *terraform.State) error {
+	ctx := context.Background()
+	client := acceptance.TestAccProvider.Meta().(*config.Config).NewFabricClientForTesting(ctx)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "equinix_fabric_stream_attachments" {
+			continue
+		}
+
+		streamID := rs.Primary.Attributes["stream_id"]
+		count, _ := strconv.Atoi(rs.Primary.Attributes["assets.#"])
+		for i := range count {
+			assetID := rs.Primary.Attributes[fmt.Sprintf("assets.%d.asset_id", i)]
+			asset := rs.Primary.Attributes[fmt.Sprintf("assets.%d.asset", i)]
+			attachment, _, err := client.StreamsApi.GetStreamAssetByUuid(ctx, assetID, fabricv4.Asset(asset), streamID).Execute()
+			if err == nil && attachment.GetAttachmentStatus() != fabricv4.STREAMASSETATTACHMENTSTATUS_DETACHED {
+				return fmt.Errorf("%s %s is still attached to fabric stream %s, status %s", asset, assetID, streamID, attachment.GetAttachmentStatus())
+			}
+		}
+	}
+	return nil
+}
+
+func testAccFabricStreamAttachmentsConfig() string {
+	return `
+		resource "equinix_fabric_stream" "new_stream" {
+		  type = "TELEMETRY_STREAM"
+		  name = "Attachments_Test_PFCR"
+		  description = "Testing Stream Attachments resource"
+		  project = {
+			project_id = "33ec651f-cc99-48e0-94d3-47466899cdc7"
+		  }
+		}
+
+		resource "equinix_fabric_cloud_router" "test"{
+			type = "XF_ROUTER"
+			name = "STREAM_BULK_TEST_PFCR"
+			location{
+				metro_code  = "SV"
+			}
+			package{
+				code = "STANDARD"
+			}
+			order{
+				purchase_order_number = "1-234567"
+			}
+			notifications{
+				type = "ALL"
+				emails = [
+					"test@equinix.com",
+					"test1@equinix.com"
+				]
+			}
+			project{
+				project_id = "33ec651f-cc99-48e0-94d3-47466899cdc7"
+			}
+			account {
+				account_number = 201257
+			}
+		}
+
+		resource "equinix_fabric_stream_attachments" "routers" {
+			stream_id = equinix_fabric_stream.new_stream.id
+			selector = {
+				project_id   = one(equinix_fabric_cloud_router.test.project).project_id
+				asset_type   = "routers"
+				name_pattern = "^${equinix_fabric_cloud_router.test.name}$"
+			}
+		}
+	`
+}
+
+func TestAccFabricStreamAttachments_PFCR(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		ExternalProviders:        acceptance.TestExternalProviders,
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             CheckStreamAttachmentsDelete,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFabricStreamAttachmentsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("equinix_fabric_stream_attachments.routers", "id"),
+					resource.TestCheckResourceAttr("equinix_fabric_stream_attachments.routers", "metrics_enabled", "false"),
+					resource.TestCheckResourceAttr("equinix_fabric_stream_attachments.routers", "assets.#", "1"),
+					resource.TestCheckResourceAttr("equinix_fabric_stream_attachments.routers", "assets.0.asset", "routers"),
+					resource.TestCheckResourceAttrPair(
+						"equinix_fabric_stream_attachments.routers", "assets.0.asset_id",
+						"equinix_fabric_cloud_router.test", "id"),
+				),
+			},
+		},
+	})
+}
@@ -38,9 +38,11 @@ type paginationModel struct {
 }
 
 type resourceModel struct {
-	StreamID types.String   `tfsdk:"stream_id"`
-	ID       types.String   `tfsdk:"id"`
-	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	StreamID           types.String   `tfsdk:"stream_id"`
+	ID                 types.String   `tfsdk:"id"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+	ValidateSink       types.Bool     `tfsdk:"validate_sink"`
+	LastDeliveryStatus types.String   `tfsdk:"last_delivery_status"`
 	baseStreamSubscriptionModel
 }
 
@@ -159,6 +161,8 @@ func (m *dataSourceAll) parse(ctx context.Context, streamSubscriptionsResponse *
 
 func (m *resourceModel) parse(ctx context.Context, streamSubscription *fabricv4.StreamSubscription) diag.Diagnostics {
 	m.ID = types.StringValue(streamSubscription.GetUuid())
+	lastDeliveryStatus, _ := parseLastDeliveryStatus(streamSubscription.GetOperation())
+	m.LastDeliveryStatus = types.StringValue(lastDeliveryStatus)
 
 	diags := m.baseStreamSubscriptionModel.parse(ctx, streamSubscription)
 	if diags.HasError() {
@@ -322,3 +326,25 @@ func parseSelectorModel(ctx context.Context, streamSubSelector fabricv4.StreamSu
 	}
 	return fwtypes.NewObjectValueOf[selectorModel](ctx, &selector), diags
 }
+
+// parseLastDeliveryStatus summarizes the delivery of data to the sink of a
+// stream subscription and returns the most recent delivery error, if any
+func parseLastDeliveryStatus(operation fabricv4.StreamSubscriptionOperation) (string, *fabricv4.StreamSubscriptionOperationErrors) {
+	var lastError *fabricv4.StreamSubscriptionOperationErrors
+	for _, deliveryError := range operation.GetErrors() {
+		if lastError == nil || deliveryError.GetDateTime().After(lastError.GetDateTime()) {
+			lastError = &deliveryError
+		}
+	}
+	lastDelivery, delivered := operation.GetLastSuccessfulDeliveryDateTimeOk()
+	switch {
+	case operation.SuspendedDateTime != nil:
+		return deliveryStatusSuspended, lastError
+	case lastError != nil && (!delivered || lastError.GetDateTime().After(*lastDelivery)):
+		return deliveryStatusFailed, lastError
+	case delivered:
+		return deliveryStatusDelivered, nil
+	default:
+		return deliveryStatusPending, nil
+	}
+}
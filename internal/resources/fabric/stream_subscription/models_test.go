@@ -0,0 +1,46 @@
+package streamsubscription
+
+import (
+	"testing"
+	"time"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamSubscription_parseLastDeliveryStatus(t *testing.T) {
+	// given
+	earlier := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	later := earlier.Add(time.Hour)
+	latest := later.Add(time.Hour)
+	deliveryError := fabricv4.StreamSubscriptionOperationErrors{
+		ErrorCode:    fabricv4.PtrString("EQ-3100001"),
+		ErrorMessage: fabricv4.PtrString("Invalid token"),
+		DateTime:     &later,
+	}
+	// when
+	pendingStatus, pendingError := parseLastDeliveryStatus(fabricv4.StreamSubscriptionOperation{})
+	deliveredStatus, _ := parseLastDeliveryStatus(fabricv4.StreamSubscriptionOperation{
+		LastSuccessfulDeliveryDateTime: &earlier,
+	})
+	failedStatus, failedError := parseLastDeliveryStatus(fabricv4.StreamSubscriptionOperation{
+		LastSuccessfulDeliveryDateTime: &earlier,
+		Errors:                         []fabricv4.StreamSubscriptionOperationErrors{deliveryError},
+	})
+	recoveredStatus, _ := parseLastDeliveryStatus(fabricv4.StreamSubscriptionOperation{
+		LastSuccessfulDeliveryDateTime: &latest,
+		Errors:                         []fabricv4.StreamSubscriptionOperationErrors{deliveryError},
+	})
+	suspendedStatus, _ := parseLastDeliveryStatus(fabricv4.StreamSubscriptionOperation{
+		LastSuccessfulDeliveryDateTime: &earlier,
+		SuspendedDateTime:              &later,
+	})
+	// then
+	assert.Equal(t, deliveryStatusPending, pendingStatus, "Nothing delivered yet is pending")
+	assert.Nil(t, pendingError)
+	assert.Equal(t, deliveryStatusDelivered, deliveredStatus, "Successful delivery without errors is delivered")
+	assert.Equal(t, deliveryStatusFailed, failedStatus, "Error after the last successful delivery is failed")
+	assert.Equal(t, "Invalid token", failedError.GetErrorMessage(), "Most recent error is returned")
+	assert.Equal(t, deliveryStatusDelivered, recoveredStatus, "Successful delivery after an error is delivered")
+	assert.Equal(t, deliveryStatusSuspended, suspendedStatus, "Suspended subscription is suspended")
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"slices"
+	"strings"
 	"time"
 
 	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
@@ -14,17 +15,28 @@ import (
 	"github.com/equinix/equinix-sdk-go/services/fabricv4"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
+const (
+	deliveryStatusPending   = "PENDING"
+	deliveryStatusDelivered = "DELIVERED"
+	deliveryStatusFailed    = "FAILED"
+	deliveryStatusSuspended = "SUSPENDED"
+)
+
+var deliveryStatuses = []string{deliveryStatusPending, deliveryStatusDelivered, deliveryStatusFailed, deliveryStatusSuspended}
+
 // NewResource creates ba new stream subscription
 func NewResource() resource.Resource {
 	return &Resource{
 		BaseResource: framework.NewBaseResource(
 			framework.BaseResourceConfig{
-				Name: "equinix_fabric_stream_subscription",
+				Name:          "equinix_fabric_stream_subscription",
+				ParentIDAttrs: []string{"stream_id"},
 			},
 		),
 	}
@@ -85,14 +97,33 @@ func (r *Resource) Create(
 		return
 	}
 
+	// Wait for the sink to receive data when requested; a failing sink still
+	// leaves a created subscription behind, so it is saved to state with the
+	// error and replaced on the next apply
+	subscription := streamChecked.(*fabricv4.StreamSubscription)
+	var validationErr error
+	if plan.ValidateSink.ValueBool() {
+		sinkWaiter := getSinkDeliveryWaiter(ctx, client, plan.StreamID.ValueString(), subscription.GetUuid(), createTimeout)
+		var validated any
+		validated, validationErr = sinkWaiter.WaitForStateContext(ctx)
+		if validatedSubscription, ok := validated.(*fabricv4.StreamSubscription); ok {
+			subscription = validatedSubscription
+		}
+	}
+
 	// Parse API response into the Terraform state
-	resp.Diagnostics.Append(plan.parse(ctx, streamChecked.(*fabricv4.StreamSubscription))...)
+	resp.Diagnostics.Append(plan.parse(ctx, subscription)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
+	if validationErr != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed validating sink of stream subscription %s", streamSubscription.GetUuid()), validationErr.Error())
+	}
 }
 
 // Read retrieves a new stream subscription
@@ -115,8 +146,16 @@ func (r *Resource) Read(
 	id := state.ID.ValueString()
 	streamID := state.StreamID.ValueString()
 
-	streamSubscription, _, err := client.StreamSubscriptionsApi.GetStreamSubscriptionByUuid(ctx, streamID, id).Execute()
+	streamSubscription, httpResp, err := client.StreamSubscriptionsApi.GetStreamSubscriptionByUuid(ctx, streamID, id).Execute()
+	if err == nil {
+		err = equinix_errors.CheckFabricDeletedState(id, string(streamSubscription.GetState()))
+	}
 	if err != nil {
+		if equinix_errors.IsFabricNotFound(httpResp, err) {
+			resp.Diagnostics.Append(equinix_errors.NewResourceNotFoundWarningDiagnostic(err))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("failed retrieving stream subscription %s", id), equinix_errors.FormatFabricError(err).Error())
 		return
@@ -130,6 +169,7 @@ func (r *Resource) Read(
 
 	// Update the Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 }
 
 // Update modifies an existing stream subscription
@@ -144,6 +184,8 @@ func (r *Resource) Update(
 	var state, plan resourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	// validate_sink only has its default in the plan
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("validate_sink"), &plan.ValidateSink)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -186,6 +228,7 @@ func (r *Resource) Update(
 
 	// Set the updated state back into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 }
 
 // Delete removes the stream subscription
@@ -447,6 +490,31 @@ func getCreateUpdateWaiter(ctx context.Context, client *fabricv4.APIClient, stre
 	}
 }
 
+func getSinkDeliveryWaiter(ctx context.Context, client *fabricv4.APIClient, streamID, streamSubscriptionID string, timeout time.Duration) *retry.StateChangeConf {
+	return &retry.StateChangeConf{
+		Pending: []string{
+			deliveryStatusPending,
+		},
+		Target: []string{
+			deliveryStatusDelivered,
+		},
+		Refresh: func() (any, string, error) {
+			streamSubscription, _, err := client.StreamSubscriptionsApi.GetStreamSubscriptionByUuid(ctx, streamID, streamSubscriptionID).Execute()
+			if err != nil {
+				return 0, "", err
+			}
+			status, lastError := parseLastDeliveryStatus(streamSubscription.GetOperation())
+			if lastError != nil && status != deliveryStatusDelivered {
+				return streamSubscription, status, fmt.Errorf("sink delivery %s: %s %s", strings.ToLower(status), lastError.GetErrorCode(), lastError.GetErrorMessage())
+			}
+			return streamSubscription, status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+}
+
 func getDeleteWaiter(ctx context.Context, client *fabricv4.APIClient, streamID, streamSubscriptionID string, timeout time.Duration) *retry.StateChangeConf {
 	// deletedMarker is a terraform-provider-only value that is used by the waiter
 	// to indicate that the connection appears to be deleted successfully based on
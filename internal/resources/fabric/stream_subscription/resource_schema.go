@@ -2,11 +2,13 @@ package streamsubscription
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/equinix/terraform-provider-equinix/internal/framework"
 	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -50,6 +52,16 @@ Additional Documentation:
 				Description: "Stream subscription enabled status",
 				Required:    true,
 			},
+			"validate_sink": schema.BoolAttribute{
+				Description: "Boolean value indicating whether the creation of the stream subscription waits for data to be delivered to the sink, failing when the sink reports a delivery error. Requires assets attached to the stream for data to flow",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"last_delivery_status": schema.StringAttribute{
+				Description: fmt.Sprintf("Status of the most recent delivery of data to the sink. One of %v", deliveryStatuses),
+				Computed:    true,
+			},
 			"metric_selector": schema.SingleNestedAttribute{
 				Description: "Lists of metrics to be included/excluded on the stream subscription",
 				Optional:    true,
@@ -278,6 +278,8 @@ func TestAccFabricStreamSubscription_PFCR(t *testing.T) {
 					resource.TestCheckResourceAttrSet("equinix_fabric_stream_subscription.splunk", "sink.settings.metric_index"),
 					resource.TestCheckResourceAttrSet("equinix_fabric_stream_subscription.splunk", "sink.settings.source"),
 					resource.TestCheckResourceAttrSet("equinix_fabric_stream_subscription.splunk", "uuid"),
+					resource.TestCheckResourceAttr("equinix_fabric_stream_subscription.splunk", "validate_sink", "false"),
+					resource.TestCheckResourceAttrSet("equinix_fabric_stream_subscription.splunk", "last_delivery_status"),
 					resource.TestCheckResourceAttr(
 						"data.equinix_fabric_stream_subscription.by_ids", "name", "Splunk_PFCR"),
 					resource.TestCheckResourceAttr(
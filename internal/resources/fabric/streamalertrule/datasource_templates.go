@@ -0,0 +1,95 @@
+package streamalertrule
+
+import (
+	"context"
+	"fmt"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NewDataSourceAlertRuleTemplates creates a new data source for the built-in
+// stream alert rule templates
+func NewDataSourceAlertRuleTemplates() datasource.DataSource {
+	return &DataSourceAlertRuleTemplates{
+		BaseDataSource: framework.NewBaseDataSource(
+			framework.BaseDataSourceConfig{
+				Name: "equinix_fabric_stream_alert_rule_templates",
+			},
+		),
+	}
+}
+
+// DataSourceAlertRuleTemplates datasource represents the stream alert rule
+// templates for an asset
+type DataSourceAlertRuleTemplates struct {
+	framework.BaseDataSource
+}
+
+// Schema returns the datasource schema
+func (r *DataSourceAlertRuleTemplates) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = dataSourceAlertRuleTemplatesSchema(ctx)
+}
+
+// Read renders the templates of the asset type for the asset, retrieving
+// the asset bandwidth when it is not configured
+func (r *DataSourceAlertRuleTemplates) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceAlertRuleTemplatesModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	assetType := data.AssetType.ValueString()
+	assetID := data.AssetID.ValueString()
+	if data.Bandwidth.IsNull() || data.Bandwidth.IsUnknown() {
+		client := r.Meta.NewFabricClientForFramework(ctx, request.ProviderMeta)
+		bandwidth, err := getAssetBandwidth(ctx, client, assetType, assetID)
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("api error retrieving bandwidth of %s %s", assetType, assetID), equinix_errors.FormatFabricError(err).Error())
+			return
+		}
+		data.Bandwidth = types.Int32Value(bandwidth)
+	}
+
+	var templates []alertRuleTemplate
+	for _, template := range alertRuleTemplates {
+		if template.assetType == assetType {
+			templates = append(templates, template)
+		}
+	}
+
+	response.Diagnostics.Append(data.parse(ctx, templates)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// getAssetBandwidth returns the bandwidth in Mbps of the port or connection
+func getAssetBandwidth(ctx context.Context, client *fabricv4.APIClient, assetType, assetID string) (int32, error) {
+	switch assetType {
+	case templateAssetPorts:
+		port, _, err := client.PortsApi.GetPortByUuid(ctx, assetID).Execute()
+		if err != nil {
+			return 0, err
+		}
+		return port.GetBandwidth(), nil //nolint:staticcheck // Deprecated in favor of physical ports speed, still the only total bandwidth reported
+	case templateAssetConnections:
+		connection, _, err := client.ConnectionsApi.GetConnectionByUuid(ctx, assetID).Execute()
+		if err != nil {
+			return 0, err
+		}
+		return connection.GetBandwidth(), nil
+	default:
+		return 0, fmt.Errorf("unsupported asset type %s", assetType)
+	}
+}
@@ -2,11 +2,15 @@ package streamalertrule
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/equinix/terraform-provider-equinix/internal/framework"
 	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -232,3 +236,109 @@ func getStreamAlertRuleSchema(ctx context.Context) map[string]schema.Attribute {
 		},
 	}
 }
+
+func dataSourceAlertRuleTemplatesSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: `Fabric V4 API compatible data source that allows user to fetch ready-to-use Equinix Fabric Stream Alert Rule definitions for common metrics of a port or connection
+
+The templates come from a catalog built into the provider. Bandwidth utilization thresholds are computed from the bandwidth of the asset.
+
+Additional Documentation:
+* Getting Started: https://docs.equinix.com/observability/streaming-data/integratewithsink/
+* API: https://docs.equinix.com/api-catalog/fabricv4/#tag/Stream-Alert-Rules`,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttributeDefaultDescription(),
+			"asset_type": schema.StringAttribute{
+				Description: fmt.Sprintf("Equinix defined asset category to retrieve the alert rule templates of. One of %v", templateAssetTypes),
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(templateAssetTypes...),
+				},
+			},
+			"asset_id": schema.StringAttribute{
+				Description: "The uuid of the asset the alert rules are for",
+				Required:    true,
+			},
+			"bandwidth": schema.Int32Attribute{
+				Description: "Bandwidth of the asset in Mbps, used to compute bandwidth utilization thresholds. Retrieved from the asset when not set",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"data": schema.ListNestedAttribute{
+				Description: "Stream alert rule templates for the asset",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[alertRuleTemplateModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Template name",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the stream alert rule",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Template description",
+							Computed:    true,
+						},
+						"resource_selector": schema.SingleNestedAttribute{
+							Description: "Resource selector for the stream alert rule",
+							Computed:    true,
+							CustomType:  fwtypes.NewObjectTypeOf[selectorModel](ctx),
+							Attributes: map[string]schema.Attribute{
+								"include": schema.ListAttribute{
+									Description: "List of resources to include",
+									ElementType: types.StringType,
+									Computed:    true,
+								},
+							},
+						},
+						"metric_selector": schema.SingleNestedAttribute{
+							Description: "Metric selector for the stream alert rule",
+							Computed:    true,
+							CustomType:  fwtypes.NewObjectTypeOf[selectorModel](ctx),
+							Attributes: map[string]schema.Attribute{
+								"include": schema.ListAttribute{
+									Description: "List of metrics to include",
+									ElementType: types.StringType,
+									Computed:    true,
+								},
+							},
+						},
+						"detection_method": schema.SingleNestedAttribute{
+							Description: "Detection method for the stream alert rule",
+							Computed:    true,
+							CustomType:  fwtypes.NewObjectTypeOf[metricSelectorModel](ctx),
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									Description: "Stream alert rule detection method type",
+									Computed:    true,
+								},
+								"window_size": schema.StringAttribute{
+									Description: "Stream alert rule metric window size",
+									Computed:    true,
+								},
+								"operand": schema.StringAttribute{
+									Description: "Stream alert rule metric operand",
+									Computed:    true,
+								},
+								"warning_threshold": schema.StringAttribute{
+									Description: "Stream alert rule metric warning threshold",
+									Computed:    true,
+								},
+								"critical_threshold": schema.StringAttribute{
+									Description: "Stream alert rule metric critical threshold",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -206,3 +206,55 @@ func (m *dataSourceStreamAlertRuleByIDsModel) parse(ctx context.Context, streamA
 
 	return diags
 }
+
+type dataSourceAlertRuleTemplatesModel struct {
+	ID        types.String                                            `tfsdk:"id"`
+	AssetType types.String                                            `tfsdk:"asset_type"`
+	AssetID   types.String                                            `tfsdk:"asset_id"`
+	Bandwidth types.Int32                                             `tfsdk:"bandwidth"`
+	Data      fwtypes.ListNestedObjectValueOf[alertRuleTemplateModel] `tfsdk:"data"`
+}
+
+type alertRuleTemplateModel struct {
+	Name             types.String                               `tfsdk:"name"`
+	Type             types.String                               `tfsdk:"type"`
+	Description      types.String                               `tfsdk:"description"`
+	ResourceSelector fwtypes.ObjectValueOf[selectorModel]       `tfsdk:"resource_selector"`
+	MetricSelector   fwtypes.ObjectValueOf[selectorModel]       `tfsdk:"metric_selector"`
+	DetectionMethod  fwtypes.ObjectValueOf[metricSelectorModel] `tfsdk:"detection_method"`
+}
+
+func (m *dataSourceAlertRuleTemplatesModel) parse(ctx context.Context, templates []alertRuleTemplate) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.ID = m.AssetID
+
+	assetID := m.AssetID.ValueString()
+	data := make([]alertRuleTemplateModel, len(templates))
+	for i, template := range templates {
+		resourceSelector := selectorModel{
+			Include: fwtypes.NewListValueOfMust[types.String](ctx, int_fw.StringSliceToAttrValue([]string{template.resourceSelector(assetID)})),
+		}
+		metricSelector := selectorModel{
+			Include: fwtypes.NewListValueOfMust[types.String](ctx, int_fw.StringSliceToAttrValue([]string{template.metricName})),
+		}
+		warningThreshold, criticalThreshold := template.thresholds(m.Bandwidth.ValueInt32())
+		detectionMethod := metricSelectorModel{
+			Type:              types.StringValue(string(fabricv4.DETECTIONMETHODTYPE_THRESHOLD)),
+			WindowSize:        types.StringValue(template.windowSize),
+			Operand:           types.StringValue(string(template.operand)),
+			WarningThreshold:  types.StringValue(warningThreshold),
+			CriticalThreshold: types.StringValue(criticalThreshold),
+		}
+		data[i] = alertRuleTemplateModel{
+			Name:             types.StringValue(template.name),
+			Type:             types.StringValue(string(fabricv4.STREAMALERTRULETYPE_METRIC_ALERT)),
+			Description:      types.StringValue(template.description),
+			ResourceSelector: fwtypes.NewObjectValueOf[selectorModel](ctx, &resourceSelector),
+			MetricSelector:   fwtypes.NewObjectValueOf[selectorModel](ctx, &metricSelector),
+			DetectionMethod:  fwtypes.NewObjectValueOf[metricSelectorModel](ctx, &detectionMethod),
+		}
+	}
+	m.Data = fwtypes.NewListNestedObjectValueOfValueSlice[alertRuleTemplateModel](ctx, data)
+
+	return diags
+}
@@ -24,7 +24,8 @@ func NewResource() resource.Resource {
 	return &Resource{
 		BaseResource: framework.NewBaseResource(
 			framework.BaseResourceConfig{
-				Name: "equinix_fabric_stream_alert_rule",
+				Name:          "equinix_fabric_stream_alert_rule",
+				ParentIDAttrs: []string{"stream_id"},
 			},
 		),
 	}
@@ -94,6 +95,7 @@ func (r *Resource) Create(
 
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 }
 
 func getCreateUpdateWaiter(ctx context.Context, client *fabricv4.APIClient, streamID, streamAlertRuleID string, timeout time.Duration) *retry.StateChangeConf {
@@ -234,8 +236,16 @@ func (r *Resource) Read(
 	id := state.ID.ValueString()
 	streamAlertRuleID := state.StreamID.ValueString()
 
-	streamAlertRule, _, err := client.StreamAlertRulesApi.GetStreamAlertRuleByUuid(ctx, streamAlertRuleID, id).Execute()
+	streamAlertRule, httpResp, err := client.StreamAlertRulesApi.GetStreamAlertRuleByUuid(ctx, streamAlertRuleID, id).Execute()
+	if err == nil {
+		err = equinix_errors.CheckFabricDeletedState(id, string(streamAlertRule.GetState()))
+	}
 	if err != nil {
+		if equinix_errors.IsFabricNotFound(httpResp, err) {
+			resp.Diagnostics.Append(equinix_errors.NewResourceNotFoundWarningDiagnostic(err))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("failed retrieving stream alert rule %s", id), equinix_errors.FormatFabricError(err).Error())
 		return
@@ -249,6 +259,7 @@ func (r *Resource) Read(
 
 	// Update the Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 }
 
 // Update modifies an existing stream alert rule
@@ -321,6 +332,7 @@ func (r *Resource) Update(
 
 	// Set the updated state back into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.SetIdentity(ctx, resp.State, resp.Identity)...)
 }
 
 func buildUpdateRequest(ctx context.Context, config streamAlertRuleResourceModel) (fabricv4.AlertRulePutRequest, diag.Diagnostics) {
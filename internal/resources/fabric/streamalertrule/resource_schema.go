@@ -8,10 +8,13 @@ import (
 	"github.com/equinix/terraform-provider-equinix/internal/framework"
 	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -77,9 +80,16 @@ Additional Documentation:
 				},
 				Attributes: map[string]schema.Attribute{
 					"include": schema.ListAttribute{
-						Description: "List of metrics to include",
+						Description: "List of Fabric metrics to include, named `equinix.fabric.{asset}.{metric}`. Metrics missing from the catalog are reported with a warning; " + catalogMetricNamesDescription(),
 						ElementType: types.StringType,
 						Required:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.ValueStringsAre(
+								stringvalidator.RegexMatches(metricNamePattern, "must be a Fabric metric name, named equinix.fabric.{asset}.{metric}"),
+								catalogMetricNameValidator{},
+							),
+						},
 					},
 				},
 			},
@@ -204,6 +204,11 @@ data "equinix_fabric_stream_alert_rule" "by_ids" {
   alert_rule_id = equinix_fabric_stream_alert_rule.alert_rule.uuid
 }
 
+data "equinix_fabric_stream_alert_rule_templates" "connection" {
+  asset_type = "connections"
+  asset_id   = equinix_fabric_connection.test_connection.id
+}
+
 data "equinix_fabric_stream_alert_rules" "all" {
   depends_on = [
     equinix_fabric_stream.new_stream,
@@ -304,6 +309,25 @@ func TestAccFabricStreamAlertRule_PFCR(t *testing.T) {
 							"change_log": knownvalue.NotNull(),
 						}),
 
+					testinghelpers.ExpectKnownAttributes("data.equinix_fabric_stream_alert_rule_templates.connection",
+						map[string]knownvalue.Check{
+							"bandwidth": knownvalue.Int32Exact(50),
+						}),
+
+					testinghelpers.ExpectKnownAttributesAt("data.equinix_fabric_stream_alert_rule_templates.connection",
+						tfjsonpath.New("data").AtSliceIndex(0),
+						map[string]knownvalue.Check{
+							"name": knownvalue.StringExact("connection_bandwidth_rx_utilization"),
+							"type": knownvalue.StringExact("METRIC_ALERT"),
+							"metric_selector": knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"include": knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("equinix.fabric.connection.bandwidth_rx.usage")}),
+							}),
+							"detection_method": knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"warning_threshold":  knownvalue.StringExact("40000000"),
+								"critical_threshold": knownvalue.StringExact("47500000"),
+							}),
+						}),
+
 					testinghelpers.ExpectKnownAttributesAt("data.equinix_fabric_stream_alert_rules.all",
 						tfjsonpath.New("data").AtSliceIndex(0),
 						map[string]knownvalue.Check{
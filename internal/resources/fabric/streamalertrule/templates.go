@@ -0,0 +1,222 @@
+package streamalertrule
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/equinix/terraform-provider-equinix/internal/fabric"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	templateAssetPorts       = "ports"
+	templateAssetConnections = "connections"
+)
+
+var templateAssetTypes = []string{templateAssetPorts, templateAssetConnections}
+
+// metricCatalog lists the Fabric metrics alert rules can be defined on, by
+// the asset type reporting them
+var metricCatalog = map[string][]string{
+	templateAssetPorts:       fabric.PortMetricNames,
+	templateAssetConnections: fabric.ConnectionMetricNames,
+}
+
+// metricNamePattern matches the names of Fabric metrics, including metrics
+// missing from the catalog
+var metricNamePattern = regexp.MustCompile(`^equinix\.fabric\.[a-z_]+\.[a-z0-9_]+(\.[a-z0-9_]+)*$`)
+
+// isCatalogMetricName returns whether the metric is in the catalog of any
+// asset type
+func isCatalogMetricName(name string) bool {
+	for _, names := range metricCatalog {
+		if slices.Contains(names, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// catalogMetricNameValidator warns about Fabric metrics missing from the
+// catalog, which are accepted but more likely to be typos
+type catalogMetricNameValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v catalogMetricNameValidator) Description(_ context.Context) string {
+	return "value should be a metric of the catalog"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v catalogMetricNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v catalogMetricNameValidator) ValidateString(_ context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+	name := request.ConfigValue.ValueString()
+	if !metricNamePattern.MatchString(name) || isCatalogMetricName(name) {
+		return
+	}
+	response.Diagnostics.AddAttributeWarning(
+		request.Path,
+		"Metric Missing From Catalog",
+		fmt.Sprintf("Metric %q is not in the catalog of Fabric metrics, check its name for typos; %s", name, catalogMetricNamesDescription()),
+	)
+}
+
+// catalogMetricNamesDescription lists the metrics in the catalog by asset type
+func catalogMetricNamesDescription() string {
+	descriptions := make([]string, len(templateAssetTypes))
+	for i, assetType := range templateAssetTypes {
+		descriptions[i] = fmt.Sprintf("metrics of %s include %s", assetType, fabric.FormatMetricNames(metricCatalog[assetType]))
+	}
+	return strings.Join(descriptions, "; ")
+}
+
+// alertRuleTemplate is a ready-to-use alert rule for a metric of an asset.
+// Thresholds of bandwidth usage metrics are percentages of the asset
+// bandwidth, other thresholds are absolute values
+type alertRuleTemplate struct {
+	name              string
+	description       string
+	assetType         string
+	metricName        string
+	bandwidthPercent  bool
+	operand           fabricv4.DetectionMethodOperand
+	windowSize        string
+	warningThreshold  float64
+	criticalThreshold float64
+}
+
+var alertRuleTemplates = []alertRuleTemplate{
+	{
+		name:              "port_bandwidth_rx_utilization",
+		description:       "Port inbound bandwidth utilization above 80% (warning) and 95% (critical) of the port bandwidth",
+		assetType:         templateAssetPorts,
+		metricName:        "equinix.fabric.port.bandwidth_rx.usage",
+		bandwidthPercent:  true,
+		operand:           fabricv4.DETECTIONMETHODOPERAND_ABOVE,
+		windowSize:        "PT15M",
+		warningThreshold:  80,
+		criticalThreshold: 95,
+	},
+	{
+		name:              "port_bandwidth_tx_utilization",
+		description:       "Port outbound bandwidth utilization above 80% (warning) and 95% (critical) of the port bandwidth",
+		assetType:         templateAssetPorts,
+		metricName:        "equinix.fabric.port.bandwidth_tx.usage",
+		bandwidthPercent:  true,
+		operand:           fabricv4.DETECTIONMETHODOPERAND_ABOVE,
+		windowSize:        "PT15M",
+		warningThreshold:  80,
+		criticalThreshold: 95,
+	},
+	{
+		name:              "port_packets_dropped_rx",
+		description:       "Inbound packets dropped by the port",
+		assetType:         templateAssetPorts,
+		metricName:        "equinix.fabric.port.packets_dropped_rx.count",
+		operand:           fabricv4.DETECTIONMETHODOPERAND_ABOVE,
+		windowSize:        "PT15M",
+		warningThreshold:  100,
+		criticalThreshold: 1000,
+	},
+	{
+		name:              "port_packets_dropped_tx",
+		description:       "Outbound packets dropped by the port",
+		assetType:         templateAssetPorts,
+		metricName:        "equinix.fabric.port.packets_dropped_tx.count",
+		operand:           fabricv4.DETECTIONMETHODOPERAND_ABOVE,
+		windowSize:        "PT15M",
+		warningThreshold:  100,
+		criticalThreshold: 1000,
+	},
+	{
+		name:              "port_packets_erred_rx",
+		description:       "Inbound packets with errors received by the port",
+		assetType:         templateAssetPorts,
+		metricName:        "equinix.fabric.port.packets_erred_rx.count",
+		operand:           fabricv4.DETECTIONMETHODOPERAND_ABOVE,
+		windowSize:        "PT15M",
+		warningThreshold:  10,
+		criticalThreshold: 100,
+	},
+	{
+		name:              "port_down",
+		description:       "Port no longer receiving traffic, as when it is down",
+		assetType:         templateAssetPorts,
+		metricName:        "equinix.fabric.port.bandwidth_rx.usage",
+		operand:           fabricv4.DETECTIONMETHODOPERAND_BELOW,
+		windowSize:        "PT5M",
+		warningThreshold:  1000,
+		criticalThreshold: 1,
+	},
+	{
+		name:              "connection_bandwidth_rx_utilization",
+		description:       "Connection inbound bandwidth utilization above 80% (warning) and 95% (critical) of the connection bandwidth",
+		assetType:         templateAssetConnections,
+		metricName:        "equinix.fabric.connection.bandwidth_rx.usage",
+		bandwidthPercent:  true,
+		operand:           fabricv4.DETECTIONMETHODOPERAND_ABOVE,
+		windowSize:        "PT15M",
+		warningThreshold:  80,
+		criticalThreshold: 95,
+	},
+	{
+		name:              "connection_bandwidth_tx_utilization",
+		description:       "Connection outbound bandwidth utilization above 80% (warning) and 95% (critical) of the connection bandwidth",
+		assetType:         templateAssetConnections,
+		metricName:        "equinix.fabric.connection.bandwidth_tx.usage",
+		bandwidthPercent:  true,
+		operand:           fabricv4.DETECTIONMETHODOPERAND_ABOVE,
+		windowSize:        "PT15M",
+		warningThreshold:  80,
+		criticalThreshold: 95,
+	},
+	{
+		name:              "connection_packets_dropped_rx",
+		description:       "Inbound packets dropped by the rate limit of the connection A side",
+		assetType:         templateAssetConnections,
+		metricName:        "equinix.fabric.connection.packets_dropped_rx_aside_ratelimit.count",
+		operand:           fabricv4.DETECTIONMETHODOPERAND_ABOVE,
+		windowSize:        "PT15M",
+		warningThreshold:  100,
+		criticalThreshold: 1000,
+	},
+	{
+		name:              "connection_packets_dropped_tx",
+		description:       "Outbound packets dropped by the rate limit of the connection A side",
+		assetType:         templateAssetConnections,
+		metricName:        "equinix.fabric.connection.packets_dropped_tx_aside_ratelimit.count",
+		operand:           fabricv4.DETECTIONMETHODOPERAND_ABOVE,
+		windowSize:        "PT15M",
+		warningThreshold:  100,
+		criticalThreshold: 1000,
+	},
+}
+
+// thresholds returns the warning and critical thresholds of the template for
+// an asset with the given bandwidth in Mbps, bandwidth usage being reported
+// in bits per second
+func (t alertRuleTemplate) thresholds(bandwidth int32) (string, string) {
+	warning, critical := t.warningThreshold, t.criticalThreshold
+	if t.bandwidthPercent {
+		bitsPerSecond := float64(bandwidth) * 1e6
+		warning = bitsPerSecond * warning / 100
+		critical = bitsPerSecond * critical / 100
+	}
+	return strconv.FormatFloat(warning, 'f', -1, 64), strconv.FormatFloat(critical, 'f', -1, 64)
+}
+
+// resourceSelector returns the resource selector matching the asset
+func (t alertRuleTemplate) resourceSelector(assetID string) string {
+	return fmt.Sprintf("*/%s/%s", t.assetType, assetID)
+}
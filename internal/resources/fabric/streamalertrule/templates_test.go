@@ -0,0 +1,109 @@
+package streamalertrule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/equinix/terraform-provider-equinix/internal/fabric"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamAlertRuleTemplates_metricsInCatalog(t *testing.T) {
+	// given
+	templates := alertRuleTemplates
+	// when
+	names := make(map[string]bool)
+	for _, template := range templates {
+		names[template.name] = true
+	}
+	// then
+	assert.Len(t, names, len(templates), "Template names are unique")
+	for _, template := range templates {
+		assert.Contains(t, metricCatalog[template.assetType], template.metricName, "Template %s metric is in the catalog of its asset type", template.name)
+	}
+}
+
+func TestStreamAlertRuleTemplates_metricNamePattern(t *testing.T) {
+	// given
+	catalogNames := append(append([]string{}, metricCatalog[templateAssetPorts]...), metricCatalog[templateAssetConnections]...)
+	// when
+	otherFabricMetric := metricNamePattern.MatchString("equinix.fabric.router.bandwidth_rx.usage")
+	notFabricMetric := metricNamePattern.MatchString("bandwidth_rx")
+	// then
+	for _, name := range catalogNames {
+		assert.True(t, metricNamePattern.MatchString(name), "Catalog metric %s is accepted", name)
+	}
+	assert.True(t, otherFabricMetric, "Fabric metrics missing from the catalog are accepted")
+	assert.False(t, notFabricMetric, "Names of metrics other than Fabric metrics are rejected")
+}
+
+func TestStreamAlertRuleTemplates_catalogMetricNameValidator(t *testing.T) {
+	// given
+	validate := func(name string) diag.Diagnostics {
+		response := &validator.StringResponse{}
+		catalogMetricNameValidator{}.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("include"),
+			ConfigValue: types.StringValue(name),
+		}, response)
+		return response.Diagnostics
+	}
+	// when
+	catalogMetric := validate(fabric.PortMetricNames[0])
+	typo := validate("equinix.fabric.port.bandwidth_rx.usgae")
+	// then
+	assert.Empty(t, catalogMetric, "Catalog metrics are accepted silently")
+	assert.Equal(t, 1, typo.WarningsCount(), "Fabric metrics missing from the catalog are reported with a warning")
+	assert.False(t, typo.HasError(), "Fabric metrics missing from the catalog are accepted")
+}
+
+func TestStreamAlertRuleTemplates_thresholds(t *testing.T) {
+	// given
+	utilization := alertRuleTemplate{bandwidthPercent: true, warningThreshold: 80, criticalThreshold: 95}
+	count := alertRuleTemplate{warningThreshold: 100, criticalThreshold: 1000}
+	// when
+	utilizationWarning, utilizationCritical := utilization.thresholds(10000)
+	countWarning, countCritical := count.thresholds(10000)
+	// then
+	assert.Equal(t, "8000000000", utilizationWarning, "Utilization warning threshold is a percentage of the bandwidth in bits per second")
+	assert.Equal(t, "9500000000", utilizationCritical, "Utilization critical threshold is a percentage of the bandwidth in bits per second")
+	assert.Equal(t, "100", countWarning, "Absolute warning threshold is kept")
+	assert.Equal(t, "1000", countCritical, "Absolute critical threshold is kept")
+}
+
+func TestStreamAlertRuleTemplates_parse(t *testing.T) {
+	// given
+	ctx := context.Background()
+	model := dataSourceAlertRuleTemplatesModel{
+		AssetType: types.StringValue(templateAssetConnections),
+		AssetID:   types.StringValue("connection-uuid"),
+		Bandwidth: types.Int32Value(50),
+	}
+	var templates []alertRuleTemplate
+	for _, template := range alertRuleTemplates {
+		if template.name == "connection_bandwidth_rx_utilization" {
+			templates = append(templates, template)
+		}
+	}
+	// when
+	diags := model.parse(ctx, templates)
+	var data []alertRuleTemplateModel
+	diags.Append(model.Data.ElementsAs(ctx, &data, false)...)
+	// then
+	assert.False(t, diags.HasError(), "Templates are parsed")
+	assert.Equal(t, "connection-uuid", model.ID.ValueString(), "ID is the asset uuid")
+	assert.Len(t, data, 1)
+	assert.Equal(t, "connection_bandwidth_rx_utilization", data[0].Name.ValueString())
+	assert.Equal(t, "METRIC_ALERT", data[0].Type.ValueString())
+	resourceSelector, _ := data[0].ResourceSelector.ToPtr(ctx)
+	var resources []string
+	resourceSelector.Include.ElementsAs(ctx, &resources, false)
+	assert.Equal(t, []string{"*/connections/connection-uuid"}, resources, "Resource selector matches the asset")
+	detectionMethod, _ := data[0].DetectionMethod.ToPtr(ctx)
+	assert.Equal(t, "THRESHOLD", detectionMethod.Type.ValueString())
+	assert.Equal(t, "40000000", detectionMethod.WarningThreshold.ValueString())
+}
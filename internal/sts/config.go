@@ -2,6 +2,7 @@ package sts
 
 import (
 	"sync"
+	"time"
 
 	"github.com/equinix/equinix-sdk-go/services/stsv1alpha"
 )
@@ -12,8 +13,14 @@ type Config struct {
 	StsAuthScope string
 	// ClientSecret is the application's secret.
 	StsSourceToken string
+	// SubjectTokenSource supplies the subject token for each exchange. When set
+	// it takes precedence over StsSourceToken.
+	SubjectTokenSource SubjectTokenSource
 	// StsBaseURL is the base endpoint of a server that  token endpoint
 	StsBaseURL string
+	// RefreshSkew is how long before expiry a cached token is refreshed.
+	// Defaults to authtransport.DefaultRefreshSkew.
+	RefreshSkew time.Duration
 }
 
 // StsTokenSource returns a TokenSource that returns t until t expires,
@@ -32,6 +39,7 @@ func (c *Config) StsTokenSource() *ContextAwareTokenSource {
 		restClient,
 		sync.Mutex{},
 		nil,
+		time.Time{},
 	}
 	return &source
 }
@@ -6,11 +6,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
 	"sync"
 	"time"
 
 	"github.com/equinix/equinix-sdk-go/services/stsv1alpha"
+	"github.com/equinix/terraform-provider-equinix/internal/authtransport"
 	"golang.org/x/oauth2"
 )
 
@@ -20,16 +22,19 @@ type ContextAwareTokenSource struct {
 	client *stsv1alpha.APIClient
 	mu     sync.Mutex
 	token  *oauth2.Token
+	// refreshAt is when the cached token is proactively re-exchanged
+	refreshAt time.Time
 }
 
 // OidcTokenExchange performs an OIDC token exchange using the configured STS client and settings.
-// It ensures thread safety, validates required configuration, and caches the token until expiry.
+// It ensures thread safety, validates required configuration, and caches the token until
+// shortly before expiry (see Config.RefreshSkew).
 // Returns a valid OAuth2 token or an error if the exchange fails.
 func (s *ContextAwareTokenSource) OidcTokenExchange(ctx context.Context) (*oauth2.Token, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if s.token != nil && s.token.Valid() {
+	if !authtransport.NeedsRefresh(s.token, s.refreshAt) {
 		return s.token, nil
 	}
 
@@ -37,16 +42,54 @@ func (s *ContextAwareTokenSource) OidcTokenExchange(ctx context.Context) (*oauth
 		return nil, err
 	}
 
-	token, err := s.executeTokenExchangeWithRetry(ctx)
+	// The subject token is resolved for every exchange so rotated workload
+	// identity tokens are picked up when the cached access token expires
+	subjectToken, err := s.subjectTokenSource().SubjectToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error resolving subject token for OIDC token exchange: %w", err)
+	}
+
+	issuedAt := time.Now()
+	token, err := s.executeTokenExchangeWithRetry(ctx, subjectToken)
 	if err != nil {
 		return nil, err
 	}
 
 	s.token = token
+	s.refreshAt = authtransport.RefreshAt(issuedAt, token.Expiry, s.refreshSkew())
 	return s.token, nil
 }
 
-func (s *ContextAwareTokenSource) executeTokenExchangeWithRetry(ctx context.Context) (*oauth2.Token, error) {
+// TokenWithContext implements authtransport.TokenSource
+func (s *ContextAwareTokenSource) TokenWithContext(ctx context.Context) (*oauth2.Token, error) {
+	token, err := s.OidcTokenExchange(ctx)
+	if err != nil {
+		log.Printf("ContextAwareTransport: error during OIDC token exchange: %v", err)
+		return nil, fmt.Errorf("ContextAwareTransport: OIDC token exchange failed: %w", err)
+	}
+	return token, nil
+}
+
+// Invalidate drops the cached token if it is still token, forcing the next
+// call to OidcTokenExchange to perform a new exchange
+func (s *ContextAwareTokenSource) Invalidate(token *oauth2.Token) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && token != nil && s.token.AccessToken == token.AccessToken {
+		s.token = nil
+		s.refreshAt = time.Time{}
+	}
+}
+
+func (s *ContextAwareTokenSource) refreshSkew() time.Duration {
+	if s.conf.RefreshSkew > 0 {
+		return s.conf.RefreshSkew
+	}
+	return authtransport.DefaultRefreshSkew
+}
+
+func (s *ContextAwareTokenSource) executeTokenExchangeWithRetry(ctx context.Context, subjectToken string) (*oauth2.Token, error) {
 	maxRetries := 5
 	baseDelay := 100 * time.Millisecond
 
@@ -54,7 +97,7 @@ func (s *ContextAwareTokenSource) executeTokenExchangeWithRetry(ctx context.Cont
 		response, httpResp, err := s.client.UseApi.UseTokenPost(ctx).
 			GrantType(stsv1alpha.USETOKENPOSTREQUESTGRANTTYPE_URN_IETF_PARAMS_OAUTH_GRANT_TYPE_TOKEN_EXCHANGE).
 			Scope(s.conf.StsAuthScope).
-			SubjectToken(s.conf.StsSourceToken).
+			SubjectToken(subjectToken).
 			SubjectTokenType(stsv1alpha.USETOKENPOSTREQUESTSUBJECTTOKENTYPE_URN_IETF_PARAMS_OAUTH_TOKEN_TYPE_ID_TOKEN).
 			Execute()
 
@@ -77,12 +120,19 @@ func (s *ContextAwareTokenSource) validateConfig() error {
 	if s.conf.StsAuthScope == "" {
 		return fmt.Errorf("authorization scope cannot be empty for OIDC token exchange")
 	}
-	if s.conf.StsSourceToken == "" {
+	if s.conf.SubjectTokenSource == nil && s.conf.StsSourceToken == "" {
 		return fmt.Errorf("sts source token cannot be empty for OIDC token exchange")
 	}
 	return nil
 }
 
+func (s *ContextAwareTokenSource) subjectTokenSource() SubjectTokenSource {
+	if s.conf.SubjectTokenSource != nil {
+		return s.conf.SubjectTokenSource
+	}
+	return StaticSubjectToken(s.conf.StsSourceToken)
+}
+
 func (s *ContextAwareTokenSource) shouldRetry(httpResp *http.Response) bool {
 	return httpResp != nil && httpResp.StatusCode == 409
 }
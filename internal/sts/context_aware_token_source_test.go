@@ -0,0 +1,70 @@
+package sts
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSts_TransportReExchangesAfter401(t *testing.T) {
+	// given
+	fake := &fakeStsServer{expiresIn: 3600}
+	stsServer := httptest.NewServer(fake.handler(t))
+	defer stsServer.Close()
+
+	var authHeaders []string
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeaders = append(authHeaders, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") == "Bearer access-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer apiServer.Close()
+
+	conf := Config{
+		StsAuthScope:   "roleassignments:123",
+		StsBaseURL:     stsServer.URL,
+		StsSourceToken: "subject",
+	}
+	client := &http.Client{Transport: conf.New()}
+
+	// when
+	resp, err := client.Get(apiServer.URL)
+
+	// then
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{"Bearer access-1", "Bearer access-2"}, authHeaders)
+	assert.Equal(t, []string{"subject", "subject"}, fake.received())
+}
+
+func TestSts_RefreshesBeforeExpiry(t *testing.T) {
+	// given
+	fake := &fakeStsServer{expiresIn: 120}
+	server := httptest.NewServer(fake.handler(t))
+	defer server.Close()
+
+	conf := Config{
+		StsAuthScope:   "roleassignments:123",
+		StsBaseURL:     server.URL,
+		StsSourceToken: "subject",
+	}
+	source := conf.StsTokenSource()
+
+	// when
+	first, err := source.OidcTokenExchange(context.Background())
+	require.NoError(t, err)
+
+	// then
+	// A 2 minute token is refreshed after at most half its lifetime even
+	// though the default skew is longer than that
+	assert.WithinDuration(t, first.Expiry.Add(-time.Minute), source.refreshAt, time.Second)
+}
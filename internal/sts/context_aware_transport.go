@@ -1,10 +1,11 @@
 package sts
 
 import (
-	"fmt"
 	"log"
 	"net/http"
 	"sync"
+
+	"github.com/equinix/terraform-provider-equinix/internal/authtransport"
 )
 
 // ContextAwareTransport is an http.RoundTripper that uses a ContextAwareTokenSource
@@ -26,31 +27,14 @@ func (c *Config) New() *ContextAwareTransport {
 }
 
 // RoundTrip authorizes and authenticates the request with an
-// access token from ContextAwareTransport's Source.
+// access token from ContextAwareTransport's Source. A request rejected
+// with 401 Unauthorized is replayed once after a fresh token exchange.
 func (t *ContextAwareTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	reqBodyClosed := false
-	if req.Body != nil {
-		defer func() {
-			if !reqBodyClosed {
-				//nolint:errcheck // Inherited from upstream; disabling lint to avoid a larger refactor
-				req.Body.Close()
-			}
-		}()
-	}
-
-	// passing in the existing request context
-	token, err := t.Source.OidcTokenExchange(req.Context())
-	if err != nil {
-		log.Printf("ContextAwareTransport: error during OIDC token exchange: %v", err)
-		return nil, fmt.Errorf("ContextAwareTransport: OIDC token exchange failed: %w", err)
+	transport := authtransport.Transport{
+		Source: t.Source,
+		Base:   t.Base,
 	}
-
-	req2 := cloneRequest(req) // per RoundTripper contract
-	token.SetAuthHeader(req2)
-
-	// req.Body is assumed to be closed by the base RoundTripper.
-	reqBodyClosed = true
-	return t.base().RoundTrip(req2)
+	return transport.RoundTrip(req)
 }
 
 var cancelOnce sync.Once
@@ -64,24 +48,3 @@ func (t *ContextAwareTransport) CancelRequest(_ *http.Request) {
 		log.Printf("deprecated: golang.org/x/oauth2: ContextAwareTransport.CancelRequest no longer does anything; use contexts")
 	})
 }
-
-func (t *ContextAwareTransport) base() http.RoundTripper {
-	if t.Base != nil {
-		return t.Base
-	}
-	return http.DefaultTransport
-}
-
-// cloneRequest returns a clone of the provided *http.Request.
-// The clone is a shallow copy of the struct and its Header map.
-func cloneRequest(r *http.Request) *http.Request {
-	// shallow copy of the struct
-	r2 := new(http.Request)
-	*r2 = *r
-	// deep copy of the Header
-	r2.Header = make(http.Header, len(r.Header))
-	for k, s := range r.Header {
-		r2.Header[k] = append([]string(nil), s...)
-	}
-	return r2
-}
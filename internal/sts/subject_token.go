@@ -0,0 +1,120 @@
+package sts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// SubjectTokenSource supplies the OIDC subject token that is exchanged for an
+// Equinix access token. It is called every time a new exchange is needed so
+// that rotated tokens are picked up during long-running operations.
+type SubjectTokenSource interface {
+	SubjectToken(ctx context.Context) (string, error)
+}
+
+// SubjectTokenSourceFunc adapts an ordinary function to a SubjectTokenSource
+type SubjectTokenSourceFunc func(ctx context.Context) (string, error)
+
+// SubjectToken calls f(ctx)
+func (f SubjectTokenSourceFunc) SubjectToken(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// StaticSubjectToken returns a SubjectTokenSource that always returns token
+func StaticSubjectToken(token string) SubjectTokenSource {
+	return SubjectTokenSourceFunc(func(_ context.Context) (string, error) {
+		if token == "" {
+			return "", fmt.Errorf("sts source token cannot be empty for OIDC token exchange")
+		}
+		return token, nil
+	})
+}
+
+// EnvSubjectToken returns a SubjectTokenSource that reads the token from the
+// named environment variable on every call
+func EnvSubjectToken(envVar string) SubjectTokenSource {
+	return SubjectTokenSourceFunc(func(_ context.Context) (string, error) {
+		token := strings.TrimSpace(os.Getenv(envVar))
+		if token == "" {
+			return "", fmt.Errorf("environment variable %s does not contain a subject token for OIDC token exchange", envVar)
+		}
+		return token, nil
+	})
+}
+
+// FileSubjectToken returns a SubjectTokenSource that reads the token from the
+// file at path on every call. This supports Kubernetes projected service account
+// tokens and other workload identity tokens that are rotated on disk.
+func FileSubjectToken(path string) SubjectTokenSource {
+	return SubjectTokenSourceFunc(func(_ context.Context) (string, error) {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("error reading subject token file %s: %w", path, err)
+		}
+		token := strings.TrimSpace(string(content))
+		if token == "" {
+			return "", fmt.Errorf("subject token file %s is empty", path)
+		}
+		return token, nil
+	})
+}
+
+// GitHubActionsSubjectToken returns a SubjectTokenSource that requests a fresh
+// OIDC token from the GitHub Actions token endpoint on every call. requestURL and
+// requestToken are the values GitHub exposes in ACTIONS_ID_TOKEN_REQUEST_URL and
+// ACTIONS_ID_TOKEN_REQUEST_TOKEN; audience is optional.
+func GitHubActionsSubjectToken(requestURL, requestToken, audience string, client *http.Client) SubjectTokenSource {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return SubjectTokenSourceFunc(func(ctx context.Context) (string, error) {
+		if requestURL == "" || requestToken == "" {
+			return "", fmt.Errorf("GitHub Actions OIDC request URL and request token must be set; make sure the workflow has the `id-token: write` permission")
+		}
+
+		u, err := url.Parse(requestURL)
+		if err != nil {
+			return "", fmt.Errorf("invalid GitHub Actions OIDC request URL: %w", err)
+		}
+		if audience != "" {
+			query := u.Query()
+			query.Set("audience", audience)
+			u.RawQuery = query.Encode()
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+		if err != nil {
+			return "", fmt.Errorf("error creating GitHub Actions OIDC token request: %w", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+requestToken)
+		req.Header.Set("Accept", "application/json")
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return "", fmt.Errorf("GitHub Actions OIDC token request failed: %w", err)
+		}
+		defer resp.Body.Close()
+
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("GitHub Actions OIDC token request failed with status %d: %s", resp.StatusCode, string(body))
+		}
+
+		var payload struct {
+			Value string `json:"value"`
+		}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return "", fmt.Errorf("error parsing GitHub Actions OIDC token response: %w", err)
+		}
+		if payload.Value == "" {
+			return "", fmt.Errorf("GitHub Actions OIDC token response missing token value")
+		}
+		return payload.Value, nil
+	})
+}
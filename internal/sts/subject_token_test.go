@@ -0,0 +1,173 @@
+package sts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeStsServer is a local stand-in for Equinix STS that records the subject
+// tokens it receives and issues access tokens with the configured lifetime
+type fakeStsServer struct {
+	mu            sync.Mutex
+	subjectTokens []string
+	expiresIn     float32
+}
+
+func (f *fakeStsServer) handler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/use/token", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+
+		f.mu.Lock()
+		f.subjectTokens = append(f.subjectTokens, r.PostForm.Get("subject_token"))
+		count := len(f.subjectTokens)
+		f.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token":      fmt.Sprintf("access-%d", count),
+			"issued_token_type": "urn:ietf:params:oauth:token-type:access_token",
+			"token_type":        "Bearer",
+			"expires_in":        f.expiresIn,
+		})
+	}
+}
+
+func (f *fakeStsServer) received() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.subjectTokens...)
+}
+
+func TestSts_FileSubjectTokenReReadOnExchange(t *testing.T) {
+	// given
+	fake := &fakeStsServer{expiresIn: 0}
+	server := httptest.NewServer(fake.handler(t))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("subject-1\n"), 0o600))
+
+	conf := Config{
+		StsAuthScope:       "roleassignments:123",
+		StsBaseURL:         server.URL,
+		SubjectTokenSource: FileSubjectToken(tokenFile),
+	}
+	source := conf.StsTokenSource()
+
+	// when
+	first, err := source.OidcTokenExchange(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(tokenFile, []byte("subject-2"), 0o600))
+	second, err := source.OidcTokenExchange(context.Background())
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, "access-1", first.AccessToken)
+	assert.Equal(t, "access-2", second.AccessToken)
+	assert.Equal(t, []string{"subject-1", "subject-2"}, fake.received())
+}
+
+func TestSts_CachedTokenDoesNotReadSubjectToken(t *testing.T) {
+	// given
+	fake := &fakeStsServer{expiresIn: 3600}
+	server := httptest.NewServer(fake.handler(t))
+	defer server.Close()
+
+	calls := 0
+	conf := Config{
+		StsAuthScope: "roleassignments:123",
+		StsBaseURL:   server.URL,
+		SubjectTokenSource: SubjectTokenSourceFunc(func(_ context.Context) (string, error) {
+			calls++
+			return "subject", nil
+		}),
+	}
+	source := conf.StsTokenSource()
+
+	// when
+	for i := 0; i < 3; i++ {
+		_, err := source.OidcTokenExchange(context.Background())
+		require.NoError(t, err)
+	}
+
+	// then
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, []string{"subject"}, fake.received())
+}
+
+func TestSts_FileSubjectTokenErrors(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	emptyFile := filepath.Join(dir, "empty")
+	require.NoError(t, os.WriteFile(emptyFile, []byte("  \n"), 0o600))
+
+	// when
+	_, missingErr := FileSubjectToken(filepath.Join(dir, "missing")).SubjectToken(context.Background())
+	_, emptyErr := FileSubjectToken(emptyFile).SubjectToken(context.Background())
+
+	// then
+	assert.ErrorContains(t, missingErr, "error reading subject token file")
+	assert.ErrorContains(t, emptyErr, "is empty")
+}
+
+func TestSts_GitHubActionsSubjectToken(t *testing.T) {
+	// given
+	fake := &fakeStsServer{expiresIn: 0}
+	stsServer := httptest.NewServer(fake.handler(t))
+	defer stsServer.Close()
+
+	requests := 0
+	githubServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "Bearer request-token", r.Header.Get("Authorization"))
+		assert.Equal(t, "equinix", r.URL.Query().Get("audience"))
+		assert.Equal(t, "1", r.URL.Query().Get("api-version"))
+		_ = json.NewEncoder(w).Encode(map[string]string{"value": fmt.Sprintf("github-%d", requests)})
+	}))
+	defer githubServer.Close()
+
+	conf := Config{
+		StsAuthScope:       "roleassignments:123",
+		StsBaseURL:         stsServer.URL,
+		SubjectTokenSource: GitHubActionsSubjectToken(githubServer.URL+"?api-version=1", "request-token", "equinix", githubServer.Client()),
+	}
+	source := conf.StsTokenSource()
+
+	// when
+	_, err := source.OidcTokenExchange(context.Background())
+	require.NoError(t, err)
+	_, err = source.OidcTokenExchange(context.Background())
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, []string{"github-1", "github-2"}, fake.received())
+}
+
+func TestSts_GitHubActionsSubjectTokenErrors(t *testing.T) {
+	// given
+	githubServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("forbidden"))
+	}))
+	defer githubServer.Close()
+
+	// when
+	_, unsetErr := GitHubActionsSubjectToken("", "", "", nil).SubjectToken(context.Background())
+	_, statusErr := GitHubActionsSubjectToken(githubServer.URL, "request-token", "", githubServer.Client()).SubjectToken(context.Background())
+
+	// then
+	assert.ErrorContains(t, unsetErr, "id-token: write")
+	assert.ErrorContains(t, statusErr, "status 403")
+}
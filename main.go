@@ -33,7 +33,7 @@ func main() {
 
 	sdkv2ProviderFunc := func() tfprotov6.ProviderServer { return sdkv2Provider }
 	frameworkProvider := providerserver.NewProtocol6(
-		provider.CreateFrameworkProvider(version.ProviderVersion))
+		provider.CreateFrameworkProvider(version.ProviderVersion, equinix.ListResources()...))
 
 	providers := []func() tfprotov6.ProviderServer{
 		sdkv2ProviderFunc,